@@ -0,0 +1,430 @@
+package archive
+
+import (
+    "archive/tar"
+    "bytes"
+    "errors"
+    "fmt"
+    "io"
+    "io/ioutil"
+    "strconv"
+    "strings"
+    "time"
+)
+
+const (
+    arMagic     = "!<arch>\n"
+    arHeaderLen = 60
+)
+
+var errArHeader = errors.New("archive: invalid ar header")
+
+// ArFormat selects how an ArWriter stores member names.
+type ArFormat int
+
+const (
+    // ArCommon stores names of up to 16 bytes in the member header, as
+    // Debian packages do.
+    ArCommon ArFormat = iota
+    // ArGNU terminates names with "/" and keeps longer names in a "//"
+    // name table, which must be written with WriteNameTable first.
+    ArGNU
+    // ArBSD stores longer names before the member data as "#1/len".
+    ArBSD
+)
+
+// ArReader reads an ar archive, as used by Debian packages and static
+// libraries. Both the GNU and the BSD long name conventions are
+// understood; symbol tables are skipped. Members are presented with the
+// tar entry model, like tar.Reader.
+type ArReader struct {
+    r      io.Reader
+    names  []byte // GNU long name table
+    remain int64  // bytes of the current member left to read
+    pad    int64
+    err    error
+    magic  bool
+}
+
+// NewArReader returns a reader for the ar archive in r.
+func NewArReader(r io.Reader) *ArReader {
+    return &ArReader{r: r}
+}
+
+// Next advances to the next member.
+func (ar *ArReader) Next() (*tar.Header, error) {
+    if ar.err != nil {
+        return nil, ar.err
+    }
+    hdr, err := ar.next()
+    if err != nil {
+        ar.err = err
+    }
+    return hdr, err
+}
+
+func (ar *ArReader) next() (*tar.Header, error) {
+    if !ar.magic {
+        var m [len(arMagic)]byte
+        if _, err := io.ReadFull(ar.r, m[:]); err != nil {
+            return nil, err
+        }
+        if string(m[:]) != arMagic {
+            return nil, errArHeader
+        }
+        ar.magic = true
+    }
+    for {
+        if _, err := io.CopyN(ioutil.Discard, ar.r, ar.remain+ar.pad); err != nil {
+            return nil, unexpected(err)
+        }
+        ar.remain, ar.pad = 0, 0
+        var buf [arHeaderLen]byte
+        if _, err := io.ReadFull(ar.r, buf[:]); err != nil {
+            if err == io.ErrUnexpectedEOF {
+                return nil, errArHeader
+            }
+            return nil, err
+        }
+        if string(buf[58:60]) != "`\n" {
+            return nil, errArHeader
+        }
+        hdr := &tar.Header{Typeflag: tar.TypeReg}
+        mtime, err := arNumber(buf[16:28], 10)
+        if err != nil {
+            return nil, err
+        }
+        hdr.ModTime = time.Unix(mtime, 0)
+        uid, err := arNumber(buf[28:34], 10)
+        if err != nil {
+            return nil, err
+        }
+        gid, err := arNumber(buf[34:40], 10)
+        if err != nil {
+            return nil, err
+        }
+        hdr.Uid, hdr.Gid = int(uid), int(gid)
+        if hdr.Mode, err = arNumber(buf[40:48], 8); err != nil {
+            return nil, err
+        }
+        hdr.Mode &= 07777
+        if hdr.Size, err = arNumber(buf[48:58], 10); err != nil {
+            return nil, err
+        }
+        ar.remain, ar.pad = hdr.Size, hdr.Size&1
+
+        name := strings.TrimRight(string(buf[:16]), " ")
+        switch {
+        case name == "/" || name == "/SYM64/" || name == "__.SYMDEF" || name == "__.SYMDEF SORTED":
+            continue // symbol table
+        case name == "//":
+            if hdr.Size > maxArNames {
+                return nil, errArHeader
+            }
+            if ar.names, err = ar.readAll(hdr.Size); err != nil {
+                return nil, err
+            }
+            continue
+        case strings.HasPrefix(name, "#1/"):
+            n, err := strconv.ParseInt(name[3:], 10, 64)
+            if err != nil || n < 0 || n > hdr.Size || n > maxArNames {
+                return nil, errArHeader
+            }
+            b, err := ar.readAll(n)
+            if err != nil {
+                return nil, err
+            }
+            hdr.Name = string(bytes.TrimRight(b, "\x00"))
+            hdr.Size -= n
+            if hdr.Name == "__.SYMDEF" || hdr.Name == "__.SYMDEF SORTED" {
+                continue
+            }
+        case len(name) > 1 && name[0] == '/':
+            off, err := strconv.Atoi(name[1:])
+            if err != nil || off < 0 || off >= len(ar.names) {
+                return nil, errArHeader
+            }
+            s := ar.names[off:]
+            if i := bytes.IndexByte(s, '\n'); i >= 0 {
+                s = s[:i]
+            }
+            hdr.Name = strings.TrimSuffix(string(s), "/")
+        default:
+            hdr.Name = strings.TrimSuffix(name, "/")
+        }
+        return hdr, nil
+    }
+}
+
+// maxArNames bounds the GNU name table and BSD long names, which are read
+// into memory whole.
+const maxArNames = 1 << 20
+
+// readAll reads n bytes of the current member.
+func (ar *ArReader) readAll(n int64) ([]byte, error) {
+    b := make([]byte, n)
+    if _, err := io.ReadFull(ar.r, b); err != nil {
+        return nil, unexpected(err)
+    }
+    ar.remain -= n
+    return b, nil
+}
+
+// Read reads from the current member.
+func (ar *ArReader) Read(p []byte) (int, error) {
+    if ar.remain <= 0 {
+        return 0, io.EOF
+    }
+    if int64(len(p)) > ar.remain {
+        p = p[:ar.remain]
+    }
+    n, err := ar.r.Read(p)
+    ar.remain -= int64(n)
+    if err == io.EOF && ar.remain > 0 {
+        err = io.ErrUnexpectedEOF
+    }
+    return n, err
+}
+
+func arNumber(b []byte, base int) (int64, error) {
+    s := strings.TrimSpace(string(b))
+    if s == "" {
+        return 0, nil
+    }
+    n, err := strconv.ParseInt(s, base, 64)
+    if err != nil || n < 0 {
+        return 0, errArHeader
+    }
+    return n, nil
+}
+
+func unexpected(err error) error {
+    if err == io.EOF {
+        return io.ErrUnexpectedEOF
+    }
+    return err
+}
+
+// ArWriter writes an ar archive.
+type ArWriter struct {
+    w      io.Writer
+    format ArFormat
+    names  map[string]int // offsets into the GNU name table
+    remain int64
+    pad    bool
+    err    error
+    begun  bool
+}
+
+// NewArWriter returns a writer producing an ar archive in the given format.
+func NewArWriter(w io.Writer, format ArFormat) *ArWriter {
+    return &ArWriter{w: w, format: format}
+}
+
+func (aw *ArWriter) begin() error {
+    if aw.begun {
+        return nil
+    }
+    aw.begun = true
+    _, err := io.WriteString(aw.w, arMagic)
+    return err
+}
+
+// WriteNameTable writes the GNU long name table. In ArGNU format it must
+// be called before the first WriteHeader if any name is longer than 15
+// bytes; names absent from the table are rejected by WriteHeader.
+func (aw *ArWriter) WriteNameTable(names []string) error {
+    if aw.format != ArGNU {
+        return errors.New("archive: name table requires ArGNU")
+    }
+    if aw.begun {
+        return errors.New("archive: name table must precede all members")
+    }
+    if err := aw.begin(); err != nil {
+        return err
+    }
+    aw.names = make(map[string]int)
+    var table bytes.Buffer
+    for _, name := range names {
+        if len(name) < 16 {
+            continue
+        }
+        if _, ok := aw.names[name]; ok {
+            continue
+        }
+        aw.names[name] = table.Len()
+        table.WriteString(name + "/\n")
+    }
+    if table.Len() == 0 {
+        return nil
+    }
+    if err := aw.writeRaw("//", &tar.Header{Size: int64(table.Len())}, false); err != nil {
+        return err
+    }
+    _, err := aw.w.Write(table.Bytes())
+    if err == nil && table.Len()%2 == 1 {
+        _, err = io.WriteString(aw.w, "\n")
+    }
+    return err
+}
+
+// WriteHeader begins a new member. Only regular files can be stored.
+func (aw *ArWriter) WriteHeader(hdr *tar.Header) error {
+    if aw.err != nil {
+        return aw.err
+    }
+    if err := aw.finish(); err != nil {
+        return err
+    }
+    if err := aw.begin(); err != nil {
+        return err
+    }
+    if hdr.Typeflag != tar.TypeReg && hdr.Typeflag != tar.TypeRegA {
+        return fmt.Errorf("archive: ar cannot store %s: not a regular file", hdr.Name)
+    }
+    name := hdr.Name
+    if name == "" || strings.ContainsAny(name, "/\n") {
+        return fmt.Errorf("archive: invalid ar member name %q", name)
+    }
+    var err error
+    long := false // the BSD name follows the header
+    switch aw.format {
+    case ArCommon:
+        if len(name) > 16 || strings.Contains(name, " ") {
+            return fmt.Errorf("archive: ar member name %q needs ArGNU or ArBSD", name)
+        }
+        err = aw.writeRaw(name, hdr, true)
+    case ArGNU:
+        if len(name) < 16 {
+            err = aw.writeRaw(name+"/", hdr, true)
+            break
+        }
+        off, ok := aw.names[name]
+        if !ok {
+            return fmt.Errorf("archive: %q is not in the name table", name)
+        }
+        err = aw.writeRaw("/"+strconv.Itoa(off), hdr, true)
+    case ArBSD:
+        if len(name) <= 16 && !strings.Contains(name, " ") {
+            err = aw.writeRaw(name, hdr, true)
+            break
+        }
+        long = true
+        h := *hdr
+        h.Size += int64(len(name))
+        if err = aw.writeRaw("#1/"+strconv.Itoa(len(name)), &h, true); err == nil {
+            _, err = io.WriteString(aw.w, name)
+        }
+    }
+    if err != nil {
+        aw.err = err
+        return err
+    }
+    aw.remain, aw.pad = hdr.Size, hdr.Size%2 == 1
+    if long {
+        aw.pad = (hdr.Size+int64(len(name)))%2 == 1
+    }
+    return nil
+}
+
+func (aw *ArWriter) writeRaw(name string, hdr *tar.Header, meta bool) error {
+    var mtime, uid, gid, mode string
+    if meta {
+        mtime = strconv.FormatInt(hdr.ModTime.Unix(), 10)
+        if hdr.ModTime.IsZero() {
+            mtime = "0"
+        }
+        uid, gid = strconv.Itoa(hdr.Uid), strconv.Itoa(hdr.Gid)
+        mode = strconv.FormatInt(hdr.Mode&07777|0100000, 8)
+    }
+    line := fmt.Sprintf("%-16s%-12s%-6s%-6s%-8s%-10d`\n", name, mtime, uid, gid, mode, hdr.Size)
+    if len(line) != arHeaderLen {
+        return fmt.Errorf("archive: ar header field overflow for %s", hdr.Name)
+    }
+    _, err := io.WriteString(aw.w, line)
+    return err
+}
+
+// Write writes to the current member.
+func (aw *ArWriter) Write(p []byte) (int, error) {
+    if aw.err != nil {
+        return 0, aw.err
+    }
+    if int64(len(p)) > aw.remain {
+        return 0, errors.New("archive: write too long")
+    }
+    n, err := aw.w.Write(p)
+    aw.remain -= int64(n)
+    if err != nil {
+        aw.err = err
+    }
+    return n, err
+}
+
+func (aw *ArWriter) finish() error {
+    if aw.remain > 0 {
+        aw.err = errors.New("archive: missed writing member data")
+        return aw.err
+    }
+    if aw.pad {
+        aw.pad = false
+        if _, err := io.WriteString(aw.w, "\n"); err != nil {
+            aw.err = err
+            return err
+        }
+    }
+    return nil
+}
+
+// Close finishes the archive. It does not close the underlying writer.
+func (aw *ArWriter) Close() error {
+    if aw.err != nil {
+        return aw.err
+    }
+    if err := aw.finish(); err != nil {
+        return err
+    }
+    return aw.begin()
+}
+
+// ArReadWrite builds a small Debian-style ar archive and lists it.
+func ArReadWrite() {
+    var buf bytes.Buffer
+    aw := NewArWriter(&buf, ArCommon)
+    var files = []struct {
+        Name, Body string
+    }{
+        {"debian-binary", "2.0\n"},
+        {"control.tar", "control"},
+        {"data.tar", "data"},
+    }
+    for _, file := range files {
+        hdr := &tar.Header{Name: file.Name, Mode: 0644, Size: int64(len(file.Body))}
+        if err := aw.WriteHeader(hdr); err != nil {
+            fmt.Println(err)
+            return
+        }
+        if _, err := aw.Write([]byte(file.Body)); err != nil {
+            fmt.Println(err)
+            return
+        }
+    }
+    if err := aw.Close(); err != nil {
+        fmt.Println(err)
+        return
+    }
+
+    ar := NewArReader(&buf)
+    for {
+        hdr, err := ar.Next()
+        if err == io.EOF {
+            break
+        }
+        if err != nil {
+            fmt.Println(err)
+            return
+        }
+        body, _ := ioutil.ReadAll(ar)
+        fmt.Printf("Contents of %s: %q\n", hdr.Name, body)
+    }
+}
@@ -0,0 +1,104 @@
+package archive
+
+import (
+    "archive/tar"
+    "bytes"
+    "context"
+    "fmt"
+    "io"
+    "io/ioutil"
+    "path/filepath"
+    "strings"
+    "testing"
+    "time"
+)
+
+func TestArReadWrite(t *testing.T) {
+    ArReadWrite()
+}
+
+func TestArFormats(t *testing.T) {
+    names := []string{"debian-binary", "a-very-long-member-name.o", "short.o", "another long name with spaces.o", "a b", "last.o"}
+    for _, format := range []ArFormat{ArGNU, ArBSD} {
+        var buf bytes.Buffer
+        aw := NewArWriter(&buf, format)
+        if format == ArGNU {
+            if err := aw.WriteNameTable(names); err != nil {
+                t.Fatal(err)
+            }
+        }
+        mtime := time.Unix(1600000000, 0)
+        for i, name := range names {
+            body := bytes.Repeat([]byte{byte('a' + i)}, i*3+1)
+            hdr := &tar.Header{Name: name, Mode: 0640, Uid: 1000, Gid: 1000, Size: int64(len(body)), ModTime: mtime}
+            if err := aw.WriteHeader(hdr); err != nil {
+                t.Fatal(err)
+            }
+            aw.Write(body)
+        }
+        if err := aw.Close(); err != nil {
+            t.Fatal(err)
+        }
+
+        ar := NewArReader(&buf)
+        for i, name := range names {
+            hdr, err := ar.Next()
+            if err != nil {
+                t.Fatalf("format %d: %v", format, err)
+            }
+            body, err := ioutil.ReadAll(ar)
+            if err != nil {
+                t.Fatal(err)
+            }
+            if hdr.Name != name || hdr.Mode != 0640 || hdr.Uid != 1000 || !hdr.ModTime.Equal(mtime) {
+                t.Errorf("format %d: header %+v", format, hdr)
+            }
+            if want := bytes.Repeat([]byte{byte('a' + i)}, i*3+1); !bytes.Equal(body, want) {
+                t.Errorf("format %d: %s = %q, want %q", format, name, body, want)
+            }
+        }
+        if _, err := ar.Next(); err != io.EOF {
+            t.Fatalf("format %d: final Next = %v", format, err)
+        }
+    }
+}
+
+func TestArCommonRejectsLongNames(t *testing.T) {
+    aw := NewArWriter(ioutil.Discard, ArCommon)
+    if err := aw.WriteHeader(&tar.Header{Name: "a-very-long-member-name.o"}); err == nil {
+        t.Fatal("long name accepted")
+    }
+}
+
+func TestArMalformedHeaders(t *testing.T) {
+    for _, tc := range []struct{ name, size string }{
+        {"//", "-1"},
+        {"//", "9999999999"},
+        {"#1/999999999", "9999999999"},
+        {"a.o/", "-5"},
+    } {
+        raw := "!<arch>\n" + fmt.Sprintf("%-16s%-12s%-6s%-6s%-8s%-10s`\n", tc.name, "0", "0", "0", "644", tc.size)
+        _, err := NewArReader(strings.NewReader(raw)).Next()
+        if err != errArHeader {
+            t.Errorf("%s of size %s: Next = %v", tc.name, tc.size, err)
+        }
+    }
+}
+
+func TestExtractAr(t *testing.T) {
+    dir := tempDir(t)
+    p := filepath.Join(dir, "pkg.deb")
+    var buf bytes.Buffer
+    aw := NewArWriter(&buf, ArCommon)
+    aw.WriteHeader(&tar.Header{Name: "debian-binary", Mode: 0644, Size: 4})
+    aw.Write([]byte("2.0\n"))
+    aw.Close()
+    if err := ioutil.WriteFile(p, buf.Bytes(), 0644); err != nil {
+        t.Fatal(err)
+    }
+    dst := filepath.Join(dir, "out")
+    if err := Extract(context.Background(), p, dst, nil); err != nil {
+        t.Fatal(err)
+    }
+    checkTree(t, dst, map[string]string{"debian-binary": "2.0\n"})
+}
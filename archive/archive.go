@@ -0,0 +1,157 @@
+package archive
+
+import (
+    "archive/tar"
+    "archive/zip"
+    "bytes"
+    "errors"
+    "io"
+    "os"
+    "path"
+    "path/filepath"
+    "strings"
+)
+
+// Format identifies an archive container format.
+type Format int
+
+const (
+    FormatUnknown Format = iota
+    FormatTar
+    FormatZip
+    FormatAr
+    FormatCpio
+)
+
+func (f Format) String() string {
+    switch f {
+    case FormatTar:
+        return "tar"
+    case FormatZip:
+        return "zip"
+    case FormatAr:
+        return "ar"
+    case FormatCpio:
+        return "cpio"
+    }
+    return "unknown"
+}
+
+var (
+    // ErrFormat is returned when an archive format cannot be determined.
+    ErrFormat = errors.New("archive: unknown format")
+    // ErrInsecurePath is returned for entry names that escape the destination.
+    ErrInsecurePath = errors.New("archive: insecure entry path")
+)
+
+// FormatFromName guesses the format from a file name extension.
+func FormatFromName(name string) Format {
+    switch strings.ToLower(filepath.Ext(name)) {
+    case ".tar":
+        return FormatTar
+    case ".zip", ".jar":
+        return FormatZip
+    case ".a", ".ar", ".deb":
+        return FormatAr
+    case ".cpio":
+        return FormatCpio
+    }
+    return FormatUnknown
+}
+
+// DetectFormat inspects the leading bytes of an archive.
+func DetectFormat(r io.ReaderAt) Format {
+    var buf [512]byte
+    n, _ := r.ReadAt(buf[:], 0)
+    b := buf[:n]
+    switch {
+    case bytes.HasPrefix(b, []byte("PK\x03\x04")), bytes.HasPrefix(b, []byte("PK\x05\x06")):
+        return FormatZip
+    case n >= 263 && bytes.HasPrefix(b[257:], []byte("ustar")):
+        return FormatTar
+    case bytes.HasPrefix(b, []byte(arMagic)):
+        return FormatAr
+    case bytes.HasPrefix(b, []byte("07070")):
+        return FormatCpio
+    }
+    return FormatUnknown
+}
+
+// EntryReader is the sequential iteration style shared by tar.Reader,
+// ArReader and CpioReader: Next advances to an entry, whose content is
+// then read from the EntryReader itself.
+type EntryReader interface {
+    Next() (*tar.Header, error)
+    io.Reader
+}
+
+// zipHeader converts a zip entry to the tar entry model used throughout
+// this package. Linkname of symlinks is filled in by the caller, since zip
+// keeps the target in the entry content.
+func zipHeader(f *zip.FileHeader) *tar.Header {
+    fi := f.FileInfo()
+    hdr := &tar.Header{
+        Name:     f.Name,
+        Mode:     int64(fi.Mode().Perm()),
+        Size:     int64(f.UncompressedSize64),
+        ModTime:  zipModified(f),
+        Typeflag: tar.TypeReg,
+    }
+    mode := fi.Mode()
+    if mode&os.ModeSetuid != 0 {
+        hdr.Mode |= 04000
+    }
+    if mode&os.ModeSetgid != 0 {
+        hdr.Mode |= 02000
+    }
+    if mode&os.ModeSticky != 0 {
+        hdr.Mode |= 01000
+    }
+    switch {
+    case mode.IsDir():
+        hdr.Typeflag = tar.TypeDir
+        hdr.Size = 0
+    case mode&os.ModeSymlink != 0:
+        hdr.Typeflag = tar.TypeSymlink
+    }
+    return hdr
+}
+
+// tarMode returns the os.FileMode bits described by hdr.
+func tarMode(hdr *tar.Header) os.FileMode {
+    mode := os.FileMode(hdr.Mode).Perm()
+    if hdr.Mode&04000 != 0 {
+        mode |= os.ModeSetuid
+    }
+    if hdr.Mode&02000 != 0 {
+        mode |= os.ModeSetgid
+    }
+    if hdr.Mode&01000 != 0 {
+        mode |= os.ModeSticky
+    }
+    return mode
+}
+
+// cleanName normalizes an entry name to a slash separated relative path.
+// It reports ErrInsecurePath for names that would leave the archive root.
+func cleanName(name string) (string, error) {
+    name = strings.Replace(name, "\\", "/", -1)
+    if path.IsAbs(name) || (len(name) > 1 && name[1] == ':') {
+        return "", ErrInsecurePath
+    }
+    clean := path.Clean(name)
+    if clean == ".." || strings.HasPrefix(clean, "../") {
+        return "", ErrInsecurePath
+    }
+    return clean, nil
+}
+
+// EntryError records a failure that affected a single entry.
+type EntryError struct {
+    Name string
+    Err  error
+}
+
+func (e *EntryError) Error() string { return "archive: " + e.Name + ": " + e.Err.Error() }
+
+func (e *EntryError) Unwrap() error { return e.Err }
@@ -0,0 +1,121 @@
+package archive
+
+import (
+    "archive/zip"
+    "context"
+    "hash/crc32"
+    "io"
+    "os"
+    "sync"
+)
+
+// copyBufferSize is the size of the buffers shared by every copy between
+// archive entries and files.
+const copyBufferSize = 32 << 10
+
+var copyBuffers = sync.Pool{New: func() interface{} {
+    b := make([]byte, copyBufferSize)
+    return &b
+}}
+
+// copyBuffer copies src to dst like io.Copy, but with a pooled buffer.
+// Only a file destination keeps its ReaderFrom, which can copy without a
+// buffer at all; the ReaderFrom of a tar.Writer, for one, would allocate
+// a buffer of its own per entry.
+func copyBuffer(dst io.Writer, src io.Reader) (int64, error) {
+    if _, ok := dst.(*os.File); !ok {
+        dst = struct{ io.Writer }{dst}
+    }
+    bp := copyBuffers.Get().(*[]byte)
+    defer copyBuffers.Put(bp)
+    return io.CopyBuffer(dst, src, *bp)
+}
+
+// sampleBuffers holds the buffers of readSample for the default sample
+// size.
+var sampleBuffers = sync.Pool{New: func() interface{} {
+    b := make([]byte, defaultSampleSize)
+    return &b
+}}
+
+// fileRange is a stored zip entry of an archive file, opened for
+// extraction. Read goes through the zip reader, which checks the CRC as
+// usual; writeFile instead copies the range from file to file inside the
+// kernel when it can.
+type fileRange struct {
+    io.ReadCloser
+    f         *os.File
+    off, size int64
+    crc       uint32
+}
+
+// sendChunk bounds each kernel copy so that cancellation and progress
+// are noticed in between.
+const sendChunk = 8 << 20
+
+// sendTo copies the range to the current offset of dst without it passing
+// through user space, reporting false if the system cannot do that.
+func (r *fileRange) sendTo(ctx context.Context, dst *os.File, tk *tracker) (int64, bool, error) {
+    var done int64
+    for done < r.size {
+        if err := ctx.Err(); err != nil {
+            return done, true, err
+        }
+        n := r.size - done
+        if n > sendChunk {
+            n = sendChunk
+        }
+        m, err := sendFile(dst, r.f, r.off+done, n)
+        if err == errNoSendFile && done == 0 {
+            return 0, false, nil
+        }
+        done += m
+        tk.addIn(m)
+        tk.addOut(m)
+        if err != nil {
+            return done, true, err
+        }
+        if m == 0 {
+            return done, true, io.ErrUnexpectedEOF
+        }
+    }
+    // The data was never seen on the way, so check it now. It was just
+    // read into the page cache, and reading it again is far cheaper than
+    // the copy avoided.
+    h := crc32.NewIEEE()
+    if _, err := copyBuffer(h, io.NewSectionReader(r.f, r.off, r.size)); err != nil {
+        return done, true, err
+    }
+    if r.crc != 0 && h.Sum32() != r.crc {
+        return done, true, zip.ErrChecksum
+    }
+    return done, true, nil
+}
+
+// openZip opens a zip entry for extraction. Stored entries of an archive
+// file come back as a *fileRange, unless their sizes disagree: the zip
+// reader reports that as an error, and the range would not.
+func (x *extractor) openZip(f *zip.File) (io.ReadCloser, error) {
+    rc, err := f.Open()
+    if err != nil || x.src == nil || f.Method != zip.Store || f.Flags&0x1 != 0 ||
+        f.CompressedSize64 != f.UncompressedSize64 {
+        return rc, err
+    }
+    off, err := f.DataOffset()
+    if err != nil {
+        rc.Close()
+        return nil, err
+    }
+    return &fileRange{ReadCloser: rc, f: x.src, off: off, size: int64(f.CompressedSize64), crc: f.CRC32}, nil
+}
+
+// copyTo writes the content of an entry opened for extraction to dst.
+func (x *extractor) copyTo(ctx context.Context, dst *os.File, r io.Reader) error {
+    if fr, ok := r.(*fileRange); ok {
+        if _, sent, err := fr.sendTo(ctx, dst, x.tk); sent {
+            return err
+        }
+    }
+    _, err := copyBuffer(&ctxWriter{ctx: ctx, w: dst, count: x.tk.addOut}, r)
+    return err
+}
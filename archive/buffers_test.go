@@ -0,0 +1,158 @@
+package archive
+
+import (
+    "archive/zip"
+    "bytes"
+    "context"
+    "errors"
+    "fmt"
+    "hash/crc32"
+    "io/ioutil"
+    "os"
+    "path/filepath"
+    "runtime"
+    "testing"
+)
+
+func TestExtractStoredFromFile(t *testing.T) {
+    files := manyFiles(20)
+    for _, concurrency := range []int{0, 4} {
+        dir := tempDir(t)
+        good := filepath.Join(dir, "good.zip")
+        if err := ioutil.WriteFile(good, corruptZip(t, files), 0644); err != nil {
+            t.Fatal(err)
+        }
+        dst := filepath.Join(dir, "out")
+        if err := Extract(context.Background(), good, dst, &ExtractOptions{Concurrency: concurrency}); err != nil {
+            t.Fatal(err)
+        }
+        checkTree(t, dst, files)
+
+        // Stored data copied inside the kernel is still checked.
+        bad := filepath.Join(dir, "bad.zip")
+        if err := ioutil.WriteFile(bad, corruptZip(t, files, "dir3/file003.txt"), 0644); err != nil {
+            t.Fatal(err)
+        }
+        err := Extract(context.Background(), bad, filepath.Join(dir, "bad"), &ExtractOptions{Concurrency: concurrency})
+        if !errors.Is(err, zip.ErrChecksum) {
+            t.Errorf("concurrency %d: err = %v, want checksum error", concurrency, err)
+        }
+    }
+}
+
+func TestExtractStoredSizeMismatch(t *testing.T) {
+    // A stored entry whose sizes disagree is read as the zip reader
+    // reads it, which fails, not copied at its compressed size.
+    body := []byte("0123456789")
+    var buf bytes.Buffer
+    zw := zip.NewWriter(&buf)
+    fw, err := zw.CreateRaw(&zip.FileHeader{
+        Name:               "a.txt",
+        Method:             zip.Store,
+        CRC32:              crc32.ChecksumIEEE(body),
+        CompressedSize64:   uint64(len(body)),
+        UncompressedSize64: 5,
+    })
+    if err != nil {
+        t.Fatal(err)
+    }
+    fw.Write(body)
+    zw.Close()
+    dir := tempDir(t)
+    name := filepath.Join(dir, "bad.zip")
+    if err := ioutil.WriteFile(name, buf.Bytes(), 0644); err != nil {
+        t.Fatal(err)
+    }
+    for _, concurrency := range []int{0, 4} {
+        err := Extract(context.Background(), name, filepath.Join(dir, "out"), &ExtractOptions{Concurrency: concurrency})
+        if !errors.Is(err, zip.ErrFormat) {
+            t.Errorf("concurrency %d: err = %v, want a format error", concurrency, err)
+        }
+    }
+}
+
+const (
+    benchEntries   = 200
+    benchEntrySize = 16 << 10
+)
+
+// benchTree writes benchEntries files of benchEntrySize bytes under dir.
+func benchTree(b *testing.B, dir string) {
+    body := bytes.Repeat([]byte("0123456789abcdef"), benchEntrySize/16)
+    for i := 0; i < benchEntries; i++ {
+        if err := ioutil.WriteFile(filepath.Join(dir, fmt.Sprintf("f%03d.bin", i)), body, 0644); err != nil {
+            b.Fatal(err)
+        }
+    }
+}
+
+// benchArchive writes the bench tree to a file in dir in the given format.
+func benchArchive(b *testing.B, dir, name string, method uint16) string {
+    src := filepath.Join(dir, "src")
+    os.Mkdir(src, 0755)
+    benchTree(b, src)
+    dst := filepath.Join(dir, name)
+    opts := &CreateOptions{}
+    if method == zip.Store {
+        opts.Compression = &CompressionPolicy{StoreExtensions: []string{".bin"}}
+    }
+    if err := Create(context.Background(), dst, src, opts); err != nil {
+        b.Fatal(err)
+    }
+    return dst
+}
+
+// reportPerEntry adds allocations and bytes allocated per archive entry.
+func reportPerEntry(b *testing.B, run func()) {
+    var before, after runtime.MemStats
+    runtime.ReadMemStats(&before)
+    b.ResetTimer()
+    for i := 0; i < b.N; i++ {
+        run()
+    }
+    b.StopTimer()
+    runtime.ReadMemStats(&after)
+    n := float64(b.N * benchEntries)
+    b.ReportMetric(float64(after.Mallocs-before.Mallocs)/n, "allocs/entry")
+    b.ReportMetric(float64(after.TotalAlloc-before.TotalAlloc)/n, "B/entry")
+}
+
+func benchmarkExtract(b *testing.B, name string, method uint16) {
+    dir := tempDir(b)
+    src := benchArchive(b, dir, name, method)
+    b.SetBytes(benchEntries * benchEntrySize)
+    reportPerEntry(b, func() {
+        dst, err := ioutil.TempDir(dir, "dst")
+        if err != nil {
+            b.Fatal(err)
+        }
+        if err := Extract(context.Background(), src, dst, nil); err != nil {
+            b.Fatal(err)
+        }
+        os.RemoveAll(dst)
+    })
+}
+
+func BenchmarkExtractTar(b *testing.B)        { benchmarkExtract(b, "a.tar", 0) }
+func BenchmarkExtractZipStore(b *testing.B)   { benchmarkExtract(b, "a.zip", zip.Store) }
+func BenchmarkExtractZipDeflate(b *testing.B) { benchmarkExtract(b, "a.zip", zip.Deflate) }
+
+func benchmarkCreate(b *testing.B, write func(string) error) {
+    src := tempDir(b)
+    benchTree(b, src)
+    b.SetBytes(benchEntries * benchEntrySize)
+    reportPerEntry(b, func() {
+        if err := write(src); err != nil {
+            b.Fatal(err)
+        }
+    })
+}
+
+func BenchmarkWriteTar(b *testing.B) {
+    benchmarkCreate(b, func(src string) error { return WriteTar(context.Background(), ioutil.Discard, src, nil) })
+}
+
+func BenchmarkWriteZipStore(b *testing.B) {
+    opts := &CreateOptions{Compression: &CompressionPolicy{StoreExtensions: []string{".bin"}}}
+    benchmarkCreate(b, func(src string) error { return WriteZip(context.Background(), ioutil.Discard, src, opts) })
+}
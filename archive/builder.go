@@ -0,0 +1,224 @@
+package archive
+
+import (
+    "archive/tar"
+    "archive/zip"
+    "bytes"
+    "context"
+    "errors"
+    "fmt"
+    "io"
+    "io/ioutil"
+    "sort"
+    "sync"
+)
+
+// ErrBuilderClosed is returned by Builder.Add after Close.
+var ErrBuilderClosed = errors.New("archive: builder closed")
+
+// BuilderOptions configures a Builder.
+type BuilderOptions struct {
+    // Sorted writes the entries in name order when the builder is
+    // closed, whatever order they were added in, so that concurrent
+    // producers still give the same archive every time. The content of
+    // every entry is held in memory until then, and two entries with the
+    // same name fail with ErrConflict.
+    Sorted bool
+    // Pending is the number of entries that may wait for the writer
+    // before Add blocks.
+    Pending int
+}
+
+// Builder writes entries added by any number of goroutines to one tar or
+// zip archive. A single goroutine owns the archive writer and takes the
+// entries in turn, so a producer adding faster than the archive can be
+// written is held up in Add.
+//
+// Once an entry fails, or the context is cancelled, the archive is
+// abandoned: that entry's Add and every later Add and Close return the
+// first error.
+type Builder struct {
+    ctx  context.Context
+    reqs chan buildRequest
+    done chan struct{} // closed when the writer goroutine exits
+
+    mu     sync.RWMutex // held for writing to close reqs
+    closed bool
+
+    // Owned by the writer goroutine until done is closed.
+    tw     *tar.Writer
+    zw     *zip.Writer
+    sorted bool
+    held   []*ModelEntry
+    names  map[string]bool
+    err    error
+}
+
+type buildRequest struct {
+    hdr   *tar.Header
+    r     io.Reader
+    reply chan error
+}
+
+// NewBuilder returns a Builder writing an archive in format, tar or zip,
+// to w. Close must be called to finish the archive.
+func NewBuilder(ctx context.Context, w io.Writer, format Format, opts *BuilderOptions) (*Builder, error) {
+    if opts == nil {
+        opts = &BuilderOptions{}
+    }
+    b := &Builder{
+        ctx:    ctx,
+        reqs:   make(chan buildRequest, opts.Pending),
+        done:   make(chan struct{}),
+        sorted: opts.Sorted,
+        names:  map[string]bool{},
+    }
+    switch format {
+    case FormatTar:
+        b.tw = tar.NewWriter(w)
+    case FormatZip:
+        b.zw = newZipWriter(w)
+    default:
+        return nil, fmt.Errorf("%w: cannot build %v", ErrFormat, format)
+    }
+    go b.run()
+    return b, nil
+}
+
+// Add writes an entry and returns once the writer has consumed its
+// content, which regular files read from r. In a tar archive the content
+// must be exactly hdr.Size bytes, unless the builder is sorted; sorted
+// builders and zip archives take the size from the content.
+//
+// Add may be called from several goroutines at once, but not after
+// Close.
+func (b *Builder) Add(hdr *tar.Header, r io.Reader) error {
+    b.mu.RLock()
+    defer b.mu.RUnlock()
+    if b.closed {
+        return ErrBuilderClosed
+    }
+    req := buildRequest{hdr: hdr, r: r, reply: make(chan error, 1)}
+    select {
+    case b.reqs <- req:
+    case <-b.ctx.Done():
+        return b.ctx.Err()
+    }
+    return <-req.reply
+}
+
+// Close waits for the entries being added, writes the held entries of a
+// sorted builder and finishes the archive. It does not close the
+// underlying writer.
+func (b *Builder) Close() error {
+    b.mu.Lock()
+    if b.closed {
+        b.mu.Unlock()
+        return ErrBuilderClosed
+    }
+    b.closed = true
+    close(b.reqs)
+    b.mu.Unlock()
+    <-b.done
+
+    if b.err != nil {
+        return b.err
+    }
+    if b.sorted {
+        sort.Slice(b.held, func(i, j int) bool { return b.held[i].hdr.Name < b.held[j].hdr.Name })
+        for _, e := range b.held {
+            if err := b.write(e); err != nil {
+                return err
+            }
+        }
+    }
+    if b.tw != nil {
+        return b.tw.Close()
+    }
+    return b.zw.Close()
+}
+
+// run serves the requests of Add, one at a time.
+func (b *Builder) run() {
+    defer close(b.done)
+    for req := range b.reqs {
+        if b.err == nil {
+            b.err = b.ctx.Err()
+        }
+        if b.err == nil {
+            b.err = b.add(req.hdr, req.r)
+        }
+        req.reply <- b.err
+    }
+}
+
+// add writes or, for a sorted builder, holds an entry.
+func (b *Builder) add(hdr *tar.Header, r io.Reader) error {
+    key, err := cleanName(hdr.Name)
+    if err != nil || key == "." {
+        if err == nil {
+            err = errors.New("empty name")
+        }
+        return &EntryError{Name: hdr.Name, Err: err}
+    }
+    h := *hdr
+    if h.Typeflag == tar.TypeRegA {
+        h.Typeflag = tar.TypeReg
+    }
+    e := &ModelEntry{hdr: &h, comment: h.PAXRecords["comment"]}
+    if !b.sorted {
+        if h.Typeflag == tar.TypeReg {
+            e.open = func() (io.ReadCloser, error) {
+                content := &ctxReader{ctx: b.ctx, r: r}
+                if b.tw != nil {
+                    return ioutil.NopCloser(&sizedReader{r: content, left: h.Size}), nil
+                }
+                return ioutil.NopCloser(content), nil
+            }
+        }
+        return b.write(e)
+    }
+
+    if b.names[key] {
+        return &EntryError{Name: hdr.Name, Err: ErrConflict}
+    }
+    b.names[key] = true
+    if h.Typeflag == tar.TypeReg {
+        data, err := ioutil.ReadAll(&ctxReader{ctx: b.ctx, r: r})
+        if err != nil {
+            return &EntryError{Name: hdr.Name, Err: err}
+        }
+        h.Size = int64(len(data))
+        e.open = func() (io.ReadCloser, error) {
+            return ioutil.NopCloser(bytes.NewReader(data)), nil
+        }
+    }
+    b.held = append(b.held, e)
+    return nil
+}
+
+func (b *Builder) write(e *ModelEntry) error {
+    if b.tw != nil {
+        return e.saveTar(b.tw)
+    }
+    return e.saveZip(b.zw)
+}
+
+// sizedReader fails if r does not end after exactly left bytes, rather
+// than leaving the tar writer to report it against the next entry.
+type sizedReader struct {
+    r    io.Reader
+    left int64
+}
+
+func (r *sizedReader) Read(p []byte) (int, error) {
+    n, err := r.r.Read(p)
+    r.left -= int64(n)
+    if r.left < 0 {
+        return n, tar.ErrWriteTooLong
+    }
+    if err == io.EOF && r.left > 0 {
+        return n, io.ErrUnexpectedEOF
+    }
+    return n, err
+}
@@ -0,0 +1,127 @@
+package archive
+
+import (
+    "archive/tar"
+    "bytes"
+    "context"
+    "errors"
+    "fmt"
+    "io"
+    "sort"
+    "strings"
+    "sync"
+    "testing"
+    "time"
+)
+
+// buildConcurrently adds producers*perProducer files from as many
+// goroutines, returning the first error of each producer.
+func buildConcurrently(b *Builder, producers, perProducer int) []error {
+    errs := make([]error, producers)
+    var wg sync.WaitGroup
+    for p := 0; p < producers; p++ {
+        wg.Add(1)
+        go func(p int) {
+            defer wg.Done()
+            for i := 0; i < perProducer; i++ {
+                body := fmt.Sprintf("producer %d file %d", p, i)
+                hdr := &tar.Header{
+                    Name:     fmt.Sprintf("p%d/f%02d.txt", p, i),
+                    Typeflag: tar.TypeReg,
+                    Mode:     0644,
+                    Size:     int64(len(body)),
+                    ModTime:  time.Unix(1600000000, 0),
+                }
+                if err := b.Add(hdr, strings.NewReader(body)); err != nil {
+                    errs[p] = err
+                    return
+                }
+            }
+        }(p)
+    }
+    wg.Wait()
+    return errs
+}
+
+func tarNames(t *testing.T, b []byte) []string {
+    var names []string
+    tr := tar.NewReader(bytes.NewReader(b))
+    for {
+        hdr, err := tr.Next()
+        if err == io.EOF {
+            return names
+        }
+        if err != nil {
+            t.Fatal(err)
+        }
+        names = append(names, hdr.Name)
+    }
+}
+
+func TestBuilderConcurrent(t *testing.T) {
+    var outputs [2]bytes.Buffer
+    for run := range outputs {
+        b, err := NewBuilder(context.Background(), &outputs[run], FormatTar, &BuilderOptions{Sorted: true, Pending: 4})
+        if err != nil {
+            t.Fatal(err)
+        }
+        for _, err := range buildConcurrently(b, 8, 25) {
+            if err != nil {
+                t.Fatal(err)
+            }
+        }
+        if err := b.Close(); err != nil {
+            t.Fatal(err)
+        }
+    }
+    if !bytes.Equal(outputs[0].Bytes(), outputs[1].Bytes()) {
+        t.Error("sorted builds differ")
+    }
+    names := tarNames(t, outputs[0].Bytes())
+    if len(names) != 200 || !sort.StringsAreSorted(names) {
+        t.Errorf("sorted build has %d entries, sorted %v", len(names), sort.StringsAreSorted(names))
+    }
+
+    var buf bytes.Buffer
+    b, _ := NewBuilder(context.Background(), &buf, FormatZip, nil)
+    buildConcurrently(b, 8, 25)
+    if err := b.Close(); err != nil {
+        t.Fatal(err)
+    }
+    contents := modelContents(t, buf.Bytes())
+    if got := contents["p3/f07.txt"]; got != "producer 3 file 7" {
+        t.Errorf("p3/f07.txt = %q", got)
+    }
+    if len(contents) != 200 {
+        t.Errorf("zip build has %d entries", len(contents))
+    }
+    if err := b.Add(&tar.Header{Name: "late"}, nil); err != ErrBuilderClosed {
+        t.Errorf("Add after Close: err = %v", err)
+    }
+}
+
+func TestBuilderFirstError(t *testing.T) {
+    var buf bytes.Buffer
+    b, _ := NewBuilder(context.Background(), &buf, FormatTar, nil)
+    bad := &tar.Header{Name: "bad.txt", Typeflag: tar.TypeReg, Size: 10}
+    err := b.Add(bad, strings.NewReader("short"))
+    var ee *EntryError
+    if !errors.As(err, &ee) || ee.Name != "bad.txt" {
+        t.Fatalf("short content: err = %v", err)
+    }
+    for _, err := range buildConcurrently(b, 4, 3) {
+        if err != ee {
+            t.Errorf("producer err = %v, want %v", err, ee)
+        }
+    }
+    if err := b.Close(); err != ee {
+        t.Errorf("Close: err = %v, want %v", err, ee)
+    }
+
+    b, _ = NewBuilder(context.Background(), &buf, FormatTar, &BuilderOptions{Sorted: true})
+    b.Add(&tar.Header{Name: "a", Typeflag: tar.TypeDir}, nil)
+    if err := b.Add(&tar.Header{Name: "a/", Typeflag: tar.TypeDir}, nil); !errors.Is(err, ErrConflict) {
+        t.Errorf("duplicate: err = %v", err)
+    }
+    b.Close()
+}
@@ -0,0 +1,491 @@
+package archive
+
+import (
+    "bufio"
+    "compress/bzip2"
+    "io"
+    "io/ioutil"
+)
+
+const (
+    bzip2BlockMagic = 0x314159265359
+    bzip2EOSMagic   = 0x177245385090
+    // Data of a block after the initial run length encoding, for "BZh9".
+    bzip2MaxBlock = 900000 - 19
+    bzip2GroupLen = 50
+)
+
+// newBzip2Reader returns a reader decompressing the bzip2 stream in r,
+// using compress/bzip2. The standard decoder looks for a concatenated
+// stream after the end of the first one; to keep it from reading past
+// the end of the entry, input stops after the end of stream marker.
+func newBzip2Reader(r io.Reader) io.ReadCloser {
+    br, ok := r.(io.ByteReader)
+    if !ok {
+        br = bufio.NewReader(r)
+    }
+    return ioutil.NopCloser(bzip2.NewReader(&bzip2End{br: br}))
+}
+
+// bzip2End passes bytes through until the bits read end with the bzip2
+// end of stream marker, its CRC and the padding to a byte boundary.
+type bzip2End struct {
+    br     io.ByteReader
+    hi, lo uint64 // the last 128 bits read
+    n      int
+    done   bool
+}
+
+func (b *bzip2End) ReadByte() (byte, error) {
+    if b.done {
+        return 0, io.EOF
+    }
+    c, err := b.br.ReadByte()
+    if err != nil {
+        return c, err
+    }
+    b.hi = b.hi<<8 | b.lo>>56
+    b.lo = b.lo<<8 | uint64(c)
+    // The shortest stream is the 4 byte header, the 6 byte marker and
+    // the 4 byte CRC.
+    if b.n++; b.n >= 14 {
+        for pad := uint(0); pad < 8; pad++ {
+            s := pad + 32
+            if (b.lo>>s|b.hi<<(64-s))&(1<<48-1) == bzip2EOSMagic {
+                b.done = true
+                break
+            }
+        }
+    }
+    return c, nil
+}
+
+func (b *bzip2End) Read(p []byte) (int, error) {
+    for i := range p {
+        c, err := b.ReadByte()
+        if err != nil {
+            return i, err
+        }
+        p[i] = c
+    }
+    return len(p), nil
+}
+
+// bzip2Writer compresses to the bzip2 format with 900k blocks.
+type bzip2Writer struct {
+    bw       msbWriter
+    block    []byte // block data after the initial run length encoding
+    crc      uint32 // CRC of the block's uncompressed data
+    combined uint32
+    last     byte // byte of the pending run
+    run      int  // length of the pending run
+    closed   bool
+}
+
+func newBzip2Writer(w io.Writer) (io.WriteCloser, error) {
+    b := &bzip2Writer{bw: msbWriter{w: w}, crc: 0xffffffff}
+    b.bw.writeBits(uint32('B')<<24|uint32('Z')<<16|uint32('h')<<8|'9', 32)
+    return b, nil
+}
+
+func (b *bzip2Writer) Write(p []byte) (int, error) {
+    if b.bw.err != nil {
+        return 0, b.bw.err
+    }
+    for _, c := range p {
+        if b.run > 0 && (c != b.last || b.run == 255) {
+            b.flushRun()
+        }
+        b.last = c
+        b.run++
+    }
+    return len(p), b.bw.err
+}
+
+// flushRun adds the pending run to the block: runs of four to 255
+// bytes become four bytes and a repeat count.
+func (b *bzip2Writer) flushRun() {
+    n := b.run
+    if n > 4 {
+        n = 5
+    }
+    if len(b.block)+n > bzip2MaxBlock {
+        b.writeBlock()
+    }
+    for i := 0; i < b.run; i++ {
+        b.crc = b.crc<<8 ^ bzip2CRCTable[byte(b.crc>>24)^b.last]
+    }
+    if b.run < 4 {
+        for i := 0; i < b.run; i++ {
+            b.block = append(b.block, b.last)
+        }
+    } else {
+        b.block = append(b.block, b.last, b.last, b.last, b.last, byte(b.run-4))
+    }
+    b.run = 0
+}
+
+func (b *bzip2Writer) Close() error {
+    if b.closed {
+        return b.bw.err
+    }
+    b.closed = true
+    if b.run > 0 {
+        b.flushRun()
+    }
+    if len(b.block) > 0 {
+        b.writeBlock()
+    }
+    b.bw.writeBits(bzip2EOSMagic>>24, 24)
+    b.bw.writeBits(bzip2EOSMagic&0xffffff, 24)
+    b.bw.writeBits(b.combined, 32)
+    b.bw.flush()
+    return b.bw.err
+}
+
+// writeBlock compresses the pending block: Burrows-Wheeler transform,
+// move to front with zero run length encoding, and Huffman coding with
+// tables selected per group of 50 symbols.
+func (b *bzip2Writer) writeBlock() {
+    crc := ^b.crc
+    b.crc = 0xffffffff
+    b.combined = (b.combined<<1 | b.combined>>31) ^ crc
+
+    last, origPtr := bwt(b.block)
+    var inUse [256]bool
+    for _, c := range b.block {
+        inUse[c] = true
+    }
+    b.block = b.block[:0]
+    var seqToUnseq []byte
+    var unseqToSeq [256]int
+    for c, used := range inUse {
+        if used {
+            unseqToSeq[c] = len(seqToUnseq)
+            seqToUnseq = append(seqToUnseq, byte(c))
+        }
+    }
+    alphaSize := len(seqToUnseq) + 2
+    syms := bzip2MTF(last, unseqToSeq[:], len(seqToUnseq))
+
+    bw := &b.bw
+    bw.writeBits(bzip2BlockMagic>>24, 24)
+    bw.writeBits(bzip2BlockMagic&0xffffff, 24)
+    bw.writeBits(crc, 32)
+    bw.writeBits(0, 1) // not randomised
+    bw.writeBits(uint32(origPtr), 24)
+    var ranges uint32
+    for i := 0; i < 16; i++ {
+        for j := 0; j < 16; j++ {
+            if inUse[i*16+j] {
+                ranges |= 1 << uint(15-i)
+                break
+            }
+        }
+    }
+    bw.writeBits(ranges, 16)
+    for i := 0; i < 16; i++ {
+        if ranges&(1<<uint(15-i)) == 0 {
+            continue
+        }
+        var bits uint32
+        for j := 0; j < 16; j++ {
+            if inUse[i*16+j] {
+                bits |= 1 << uint(15-j)
+            }
+        }
+        bw.writeBits(bits, 16)
+    }
+
+    tables, selectors := bzip2Tables(syms, alphaSize)
+    bw.writeBits(uint32(len(tables)), 3)
+    bw.writeBits(uint32(len(selectors)), 15)
+    mtf := make([]uint8, len(tables))
+    for i := range mtf {
+        mtf[i] = uint8(i)
+    }
+    for _, sel := range selectors {
+        j := 0
+        for mtf[j] != sel {
+            j++
+        }
+        copy(mtf[1:j+1], mtf[:j])
+        mtf[0] = sel
+        for ; j > 0; j-- {
+            bw.writeBits(1, 1)
+        }
+        bw.writeBits(0, 1)
+    }
+    codes := make([][]uint32, len(tables))
+    for t, lengths := range tables {
+        cur := int(lengths[0])
+        bw.writeBits(uint32(cur), 5)
+        for _, l := range lengths {
+            for cur < int(l) {
+                bw.writeBits(2, 2)
+                cur++
+            }
+            for cur > int(l) {
+                bw.writeBits(3, 2)
+                cur--
+            }
+            bw.writeBits(0, 1)
+        }
+        codes[t] = canonicalCodes(lengths)
+    }
+    for i, s := range syms {
+        t := selectors[i/bzip2GroupLen]
+        bw.writeBits(codes[t][s], uint(tables[t][s]))
+    }
+}
+
+// bzip2MTF applies the move to front transform to data, whose bytes map
+// to the n symbols in use through unseqToSeq, and encodes runs of zeros
+// in bijective base 2 with RUNA (0) and RUNB (1). Other values v become
+// v+1, and the block ends with n+1.
+func bzip2MTF(data []byte, unseqToSeq []int, n int) []uint16 {
+    order := make([]byte, n)
+    for i := range order {
+        order[i] = byte(i)
+    }
+    syms := make([]uint16, 0, len(data)+1)
+    zeros := 0
+    flushZeros := func() {
+        for zeros > 0 {
+            zeros--
+            syms = append(syms, uint16(zeros&1))
+            zeros >>= 1
+        }
+    }
+    for _, c := range data {
+        s := byte(unseqToSeq[c])
+        j := 0
+        for order[j] != s {
+            j++
+        }
+        if j == 0 {
+            zeros++
+            continue
+        }
+        flushZeros()
+        copy(order[1:j+1], order[:j])
+        order[0] = s
+        syms = append(syms, uint16(j+1))
+    }
+    flushZeros()
+    return append(syms, uint16(n+1))
+}
+
+// bzip2Tables chooses between two and six Huffman tables for syms and
+// assigns one to each group of 50 symbols.
+func bzip2Tables(syms []uint16, alphaSize int) ([][]uint8, []uint8) {
+    nTables := 6
+    switch n := len(syms); {
+    case n < 200:
+        nTables = 2
+    case n < 600:
+        nTables = 3
+    case n < 1200:
+        nTables = 4
+    case n < 2400:
+        nTables = 5
+    }
+    freq := make([]int, alphaSize)
+    for _, s := range syms {
+        freq[s]++
+    }
+    // Start with tables favouring consecutive ranges of symbols of about
+    // equal total frequency.
+    tables := make([][]uint8, nTables)
+    remaining, lo := len(syms), 0
+    for t := 0; t < nTables; t++ {
+        target := remaining / (nTables - t)
+        hi, sum := lo, 0
+        for hi < alphaSize && (sum < target || hi == lo) {
+            sum += freq[hi]
+            hi++
+        }
+        tables[t] = make([]uint8, alphaSize)
+        for s := range tables[t] {
+            if s < lo || s >= hi {
+                tables[t][s] = 15
+            }
+        }
+        remaining -= sum
+        lo = hi
+    }
+    nGroups := (len(syms) + bzip2GroupLen - 1) / bzip2GroupLen
+    selectors := make([]uint8, nGroups)
+    for iter := 0; iter < 4; iter++ {
+        tfreq := make([][]int, nTables)
+        for t := range tfreq {
+            tfreq[t] = make([]int, alphaSize)
+        }
+        for g := 0; g < nGroups; g++ {
+            group := syms[g*bzip2GroupLen:]
+            if len(group) > bzip2GroupLen {
+                group = group[:bzip2GroupLen]
+            }
+            best, bestCost := 0, -1
+            for t, lengths := range tables {
+                cost := 0
+                for _, s := range group {
+                    cost += int(lengths[s])
+                }
+                if bestCost < 0 || cost < bestCost {
+                    best, bestCost = t, cost
+                }
+            }
+            selectors[g] = uint8(best)
+            for _, s := range group {
+                tfreq[best][s]++
+            }
+        }
+        for t := range tables {
+            // Every symbol needs a code, used or not.
+            for s := range tfreq[t] {
+                if tfreq[t][s] == 0 {
+                    tfreq[t][s] = 1
+                }
+            }
+            tables[t] = huffmanLengths(tfreq[t], 17)
+        }
+    }
+    return tables, selectors
+}
+
+// bwt returns the last column of the sorted rotations of data and the
+// row holding data itself. Rotations are sorted by prefix doubling with
+// radix sorts.
+func bwt(data []byte) ([]byte, int) {
+    n := len(data)
+    sa := make([]int32, n)
+    rank := make([]int32, n)
+    tmp := make([]int32, n)
+    cnt := make([]int32, n+256)
+    for _, c := range data {
+        cnt[c]++
+    }
+    sum := int32(0)
+    for c := 0; c < 256; c++ {
+        cnt[c], sum = sum, sum+cnt[c]
+    }
+    var start [256]int32
+    copy(start[:], cnt[:256])
+    for i, c := range data {
+        rank[i] = start[c] // rotations are ranked by the first row of their bucket
+        sa[cnt[c]] = int32(i)
+        cnt[c]++
+    }
+    for k := 1; k < n; k <<= 1 {
+        // sa is sorted by the first k bytes, so listing i-k for each i in
+        // that order sorts by the second key; a stable counting sort on
+        // the first key completes the order by 2k bytes.
+        for i := range cnt[:n] {
+            cnt[i] = 0
+        }
+        for _, r := range rank {
+            cnt[r]++
+        }
+        sum := int32(0)
+        for r := 0; r < n; r++ {
+            cnt[r], sum = sum, sum+cnt[r]
+        }
+        for _, i := range sa {
+            j := int(i) - k
+            if j < 0 {
+                j += n
+            }
+            tmp[cnt[rank[j]]] = int32(j)
+            cnt[rank[j]]++
+        }
+        sa, tmp = tmp, sa
+        // Rerank by the pair of keys.
+        distinct := true
+        key := func(i int32) (int32, int32) {
+            j := int(i) + k
+            if j >= n {
+                j -= n
+            }
+            return rank[i], rank[j]
+        }
+        newRank := tmp
+        newRank[sa[0]] = 0
+        for x := 1; x < n; x++ {
+            a1, a2 := key(sa[x-1])
+            b1, b2 := key(sa[x])
+            if a1 == b1 && a2 == b2 {
+                newRank[sa[x]] = newRank[sa[x-1]]
+                distinct = false
+            } else {
+                newRank[sa[x]] = int32(x)
+            }
+        }
+        rank, tmp = newRank, rank
+        if distinct {
+            break
+        }
+    }
+    last := make([]byte, n)
+    origPtr := 0
+    for x, i := range sa {
+        if i == 0 {
+            origPtr = x
+            last[x] = data[n-1]
+            continue
+        }
+        last[x] = data[i-1]
+    }
+    return last, origPtr
+}
+
+// bzip2CRCTable is the table for the big endian CRC-32 bzip2 uses.
+var bzip2CRCTable = func() (t [256]uint32) {
+    for i := range t {
+        c := uint32(i) << 24
+        for j := 0; j < 8; j++ {
+            if c&0x80000000 != 0 {
+                c = c<<1 ^ 0x04c11db7
+            } else {
+                c <<= 1
+            }
+        }
+        t[i] = c
+    }
+    return t
+}()
+
+// msbWriter writes a bit stream most significant bit first.
+type msbWriter struct {
+    w     io.Writer
+    bits  uint64
+    nbits uint
+    buf   []byte
+    err   error
+}
+
+func (b *msbWriter) writeBits(v uint32, n uint) {
+    b.bits = b.bits<<n | uint64(v)&(1<<n-1)
+    b.nbits += n
+    for b.nbits >= 8 {
+        b.nbits -= 8
+        b.buf = append(b.buf, byte(b.bits>>b.nbits))
+    }
+    if len(b.buf) >= 32<<10 {
+        b.flushBuf()
+    }
+}
+
+func (b *msbWriter) flushBuf() {
+    if b.err == nil {
+        _, b.err = b.w.Write(b.buf)
+    }
+    b.buf = b.buf[:0]
+}
+
+func (b *msbWriter) flush() {
+    if b.nbits > 0 {
+        b.writeBits(0, 8-b.nbits)
+    }
+    b.flushBuf()
+}
@@ -0,0 +1,61 @@
+package archive
+
+import (
+    "bytes"
+    "compress/bzip2"
+    "io/ioutil"
+    "testing"
+)
+
+func TestBzip2RoundTrip(t *testing.T) {
+    roundTrip(t, newBzip2Writer, newBzip2Reader)
+}
+
+func TestBzip2Standard(t *testing.T) {
+    // Output must be readable by a decoder that does not stop at the end
+    // of the stream, and must span several blocks.
+    in := compressInputs()["zeros"]
+    in = append(in, compressInputs()["text"]...)
+    in = append(in, compressInputs()["random"]...)
+    var buf bytes.Buffer
+    w, _ := newBzip2Writer(&buf)
+    w.Write(in)
+    w.Close()
+    out, err := ioutil.ReadAll(bzip2.NewReader(&buf))
+    if err != nil {
+        t.Fatal(err)
+    }
+    if !bytes.Equal(out, in) {
+        t.Fatal("round trip mismatch")
+    }
+}
+
+func TestBWT(t *testing.T) {
+    for _, s := range []string{"banana", "abababab", "aaaa", "x", "mississippi"} {
+        last, ptr := bwt([]byte(s))
+        // Invert with the standard LF mapping.
+        n := len(last)
+        var count [256]int
+        next := make([]int, n)
+        var start [256]int
+        for _, c := range last {
+            count[c]++
+        }
+        sum := 0
+        for c := range start {
+            start[c], sum = sum, sum+count[c]
+        }
+        for i, c := range last {
+            next[start[c]] = i
+            start[c]++
+        }
+        out := make([]byte, 0, n)
+        for i, p := 0, next[ptr]; i < n; i++ {
+            out = append(out, last[p])
+            p = next[p]
+        }
+        if string(out) != s {
+            t.Errorf("bwt(%q) inverts to %q", s, out)
+        }
+    }
+}
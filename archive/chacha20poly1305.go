@@ -0,0 +1,264 @@
+package archive
+
+import (
+    "crypto/subtle"
+    "encoding/binary"
+    "errors"
+    "math/bits"
+)
+
+// ChaCha20-Poly1305 as specified by RFC 8439. The standard library only
+// has AES-GCM, so the second cipher of the encrypted container lives
+// here, like the codecs the standard library lacks.
+
+const (
+    chachaKeySize   = 32
+    chachaNonceSize = 12
+    poly1305TagSize = 16
+)
+
+var errOpen = errors.New("archive: message authentication failed")
+
+// chacha20poly1305 implements cipher.AEAD.
+type chacha20poly1305 struct {
+    key [8]uint32
+}
+
+func newChaCha20Poly1305(key []byte) (*chacha20poly1305, error) {
+    if len(key) != chachaKeySize {
+        return nil, errors.New("archive: bad ChaCha20-Poly1305 key length")
+    }
+    c := &chacha20poly1305{}
+    for i := range c.key {
+        c.key[i] = binary.LittleEndian.Uint32(key[4*i:])
+    }
+    return c, nil
+}
+
+func (c *chacha20poly1305) NonceSize() int { return chachaNonceSize }
+func (c *chacha20poly1305) Overhead() int  { return poly1305TagSize }
+
+func (c *chacha20poly1305) Seal(dst, nonce, plaintext, additionalData []byte) []byte {
+    if len(nonce) != chachaNonceSize {
+        panic("archive: bad ChaCha20-Poly1305 nonce length")
+    }
+    ret, out := sliceForAppend(dst, len(plaintext)+poly1305TagSize)
+    var polyKey [64]byte
+    c.xorKeyStream(polyKey[:], polyKey[:], nonce, 0)
+    ct := out[:len(plaintext)]
+    c.xorKeyStream(ct, plaintext, nonce, 1)
+    tag := aeadTag(&polyKey, additionalData, ct)
+    copy(out[len(plaintext):], tag[:])
+    return ret
+}
+
+func (c *chacha20poly1305) Open(dst, nonce, ciphertext, additionalData []byte) ([]byte, error) {
+    if len(nonce) != chachaNonceSize {
+        panic("archive: bad ChaCha20-Poly1305 nonce length")
+    }
+    if len(ciphertext) < poly1305TagSize {
+        return nil, errOpen
+    }
+    ct, want := ciphertext[:len(ciphertext)-poly1305TagSize], ciphertext[len(ciphertext)-poly1305TagSize:]
+    var polyKey [64]byte
+    c.xorKeyStream(polyKey[:], polyKey[:], nonce, 0)
+    tag := aeadTag(&polyKey, additionalData, ct)
+    if subtle.ConstantTimeCompare(tag[:], want) != 1 {
+        return nil, errOpen
+    }
+    ret, out := sliceForAppend(dst, len(ct))
+    c.xorKeyStream(out, ct, nonce, 1)
+    return ret, nil
+}
+
+// aeadTag authenticates the additional data and ciphertext as in RFC
+// 8439 section 2.8, with the one-time key in the first half of polyKey.
+func aeadTag(polyKey *[64]byte, ad, ct []byte) [poly1305TagSize]byte {
+    var p poly1305
+    p.init(polyKey[:32])
+    p.writePadded(ad)
+    p.writePadded(ct)
+    var lens [16]byte
+    binary.LittleEndian.PutUint64(lens[:], uint64(len(ad)))
+    binary.LittleEndian.PutUint64(lens[8:], uint64(len(ct)))
+    p.write(lens[:])
+    return p.sum()
+}
+
+// sliceForAppend extends in by n bytes, returning the whole slice and
+// the new tail.
+func sliceForAppend(in []byte, n int) (head, tail []byte) {
+    if total := len(in) + n; cap(in) >= total {
+        head = in[:total]
+    } else {
+        head = make([]byte, total)
+        copy(head, in)
+    }
+    return head, head[len(in):]
+}
+
+// xorKeyStream xors src with the ChaCha20 key stream for nonce starting
+// at block counter into dst.
+func (c *chacha20poly1305) xorKeyStream(dst, src, nonce []byte, counter uint32) {
+    var state, block [16]uint32
+    state[0], state[1], state[2], state[3] = 0x61707865, 0x3320646e, 0x79622d32, 0x6b206574
+    copy(state[4:12], c.key[:])
+    state[13] = binary.LittleEndian.Uint32(nonce)
+    state[14] = binary.LittleEndian.Uint32(nonce[4:])
+    state[15] = binary.LittleEndian.Uint32(nonce[8:])
+    var ks [64]byte
+    for len(src) > 0 {
+        state[12] = counter
+        chachaBlock(&block, &state)
+        for i, w := range block {
+            binary.LittleEndian.PutUint32(ks[4*i:], w)
+        }
+        n := copy(dst, src[:minInt(len(src), 64)])
+        for i := 0; i < n; i++ {
+            dst[i] = src[i] ^ ks[i]
+        }
+        dst, src = dst[n:], src[n:]
+        counter++
+    }
+}
+
+func minInt(a, b int) int {
+    if a < b {
+        return a
+    }
+    return b
+}
+
+// chachaBlock computes the ChaCha20 block function of in into out.
+func chachaBlock(out, in *[16]uint32) {
+    x := *in
+    for i := 0; i < 10; i++ {
+        quarterRound(&x, 0, 4, 8, 12)
+        quarterRound(&x, 1, 5, 9, 13)
+        quarterRound(&x, 2, 6, 10, 14)
+        quarterRound(&x, 3, 7, 11, 15)
+        quarterRound(&x, 0, 5, 10, 15)
+        quarterRound(&x, 1, 6, 11, 12)
+        quarterRound(&x, 2, 7, 8, 13)
+        quarterRound(&x, 3, 4, 9, 14)
+    }
+    for i := range x {
+        out[i] = x[i] + in[i]
+    }
+}
+
+func quarterRound(x *[16]uint32, a, b, c, d int) {
+    x[a] += x[b]
+    x[d] = bits.RotateLeft32(x[d]^x[a], 16)
+    x[c] += x[d]
+    x[b] = bits.RotateLeft32(x[b]^x[c], 12)
+    x[a] += x[b]
+    x[d] = bits.RotateLeft32(x[d]^x[a], 8)
+    x[c] += x[d]
+    x[b] = bits.RotateLeft32(x[b]^x[c], 7)
+}
+
+// poly1305 is the one-time authenticator of RFC 8439 section 2.5. The
+// accumulator h is kept in three 64-bit limbs and reduced modulo
+// 2^130 - 5 after each block.
+type poly1305 struct {
+    r0, r1     uint64
+    s0, s1     uint64
+    h0, h1, h2 uint64
+    buf        [16]byte
+    n          int
+}
+
+func (p *poly1305) init(key []byte) {
+    p.r0 = binary.LittleEndian.Uint64(key) & 0x0ffffffc0fffffff
+    p.r1 = binary.LittleEndian.Uint64(key[8:]) & 0x0ffffffc0ffffffc
+    p.s0 = binary.LittleEndian.Uint64(key[16:])
+    p.s1 = binary.LittleEndian.Uint64(key[24:])
+}
+
+func (p *poly1305) write(b []byte) {
+    if p.n > 0 {
+        k := copy(p.buf[p.n:], b)
+        p.n += k
+        b = b[k:]
+        if p.n < 16 {
+            return
+        }
+        p.block(p.buf[:], 1)
+        p.n = 0
+    }
+    for len(b) >= 16 {
+        p.block(b[:16], 1)
+        b = b[16:]
+    }
+    p.n = copy(p.buf[:], b)
+}
+
+// writePadded writes b followed by zeros up to a multiple of 16 bytes.
+func (p *poly1305) writePadded(b []byte) {
+    p.write(b)
+    if r := len(b) % 16; r != 0 {
+        var zeros [16]byte
+        p.write(zeros[:16-r])
+    }
+}
+
+// block adds a 16-byte block, with hibit set above it unless it is the
+// padded final one, and multiplies by r.
+func (p *poly1305) block(b []byte, hibit uint64) {
+    var c uint64
+    p.h0, c = bits.Add64(p.h0, binary.LittleEndian.Uint64(b), 0)
+    p.h1, c = bits.Add64(p.h1, binary.LittleEndian.Uint64(b[8:]), c)
+    p.h2 += c + hibit
+
+    // h * r, with h2 and both limbs of r small enough that h2*r0 and
+    // h2*r1 fit in 64 bits.
+    h0r0hi, h0r0lo := bits.Mul64(p.h0, p.r0)
+    h1r0hi, h1r0lo := bits.Mul64(p.h1, p.r0)
+    h0r1hi, h0r1lo := bits.Mul64(p.h0, p.r1)
+    h1r1hi, h1r1lo := bits.Mul64(p.h1, p.r1)
+    h2r0 := p.h2 * p.r0
+    h2r1 := p.h2 * p.r1
+
+    t0 := h0r0lo
+    t1, c := bits.Add64(h0r0hi, h1r0lo, 0)
+    t2, c2 := bits.Add64(h1r0hi, h1r1lo, c)
+    t3 := h1r1hi + c2
+    t1, c = bits.Add64(t1, h0r1lo, 0)
+    t2, c2 = bits.Add64(t2, h0r1hi, c)
+    t3 += c2
+    t2, c = bits.Add64(t2, h2r0, 0)
+    t3 += h2r1 + c
+
+    // t = t mod 2^130 + 5 * (t >> 130), folding 4k + k for the high part.
+    ccLo, ccHi := t2&^3, t3
+    p.h0, c = bits.Add64(t0, ccLo, 0)
+    p.h1, c = bits.Add64(t1, ccHi, c)
+    p.h2 = t2&3 + c
+    ccLo, ccHi = ccLo>>2|ccHi<<62, ccHi>>2
+    p.h0, c = bits.Add64(p.h0, ccLo, 0)
+    p.h1, c = bits.Add64(p.h1, ccHi, c)
+    p.h2 += c
+}
+
+func (p *poly1305) sum() [poly1305TagSize]byte {
+    if p.n > 0 {
+        var last [16]byte
+        copy(last[:], p.buf[:p.n])
+        last[p.n] = 1
+        p.block(last[:], 0)
+    }
+    // Reduce h fully: subtract p = 2^130 - 5 if h + 5 reaches 2^130.
+    g0, c := bits.Add64(p.h0, 5, 0)
+    g1, c := bits.Add64(p.h1, 0, c)
+    g2 := p.h2 + c
+    mask := -(g2 >> 2) // all ones if h >= p, in constant time
+    h0 := p.h0&^mask | g0&mask
+    h1 := p.h1&^mask | g1&mask
+    h0, c = bits.Add64(h0, p.s0, 0)
+    h1, _ = bits.Add64(h1, p.s1, c)
+    var tag [poly1305TagSize]byte
+    binary.LittleEndian.PutUint64(tag[:], h0)
+    binary.LittleEndian.PutUint64(tag[8:], h1)
+    return tag
+}
@@ -0,0 +1,331 @@
+package archive
+
+//go:generate python3 gen/mktables.py
+
+import (
+    "archive/tar"
+    "archive/zip"
+    "compress/flate"
+    "encoding/base64"
+    "encoding/binary"
+    "errors"
+    "hash/crc32"
+    "io/ioutil"
+    "strings"
+    "sync"
+    "unicode/utf8"
+)
+
+const (
+    unicodePathExtraID    = 0x7075
+    unicodeCommentExtraID = 0x6375
+)
+
+// Charset identifies the character set of entry names. Zip archives
+// written without the language encoding flag, and tar, ar and cpio
+// archives, store names as bytes in whatever encoding the system that
+// wrote them used.
+type Charset int
+
+const (
+    // CharsetNone leaves names as they are stored.
+    CharsetNone Charset = iota
+    // CharsetAuto guesses the character set from the names themselves.
+    CharsetAuto
+    CharsetUTF8
+    CharsetCP437
+    CharsetGBK
+    CharsetShiftJIS
+    CharsetBig5
+)
+
+var charsetNames = [...]string{"none", "auto", "utf-8", "cp437", "gbk", "shift-jis", "big5"}
+
+func (c Charset) String() string {
+    if c < 0 || int(c) >= len(charsetNames) {
+        return "unknown"
+    }
+    return charsetNames[c]
+}
+
+// ParseCharset returns the character set called name, as spelled by
+// String, ignoring case.
+func ParseCharset(name string) (Charset, error) {
+    for i, n := range charsetNames {
+        if strings.EqualFold(name, n) {
+            return Charset(i), nil
+        }
+    }
+    return CharsetNone, errors.New("archive: unknown character set " + name)
+}
+
+// ErrCharset is returned when a name is not valid in a character set.
+var ErrCharset = errors.New("archive: name is not valid in the character set")
+
+// Decode converts s from the character set to UTF-8. CharsetAuto guesses
+// the character set of s alone, which is less reliable than DetectCharset
+// over all the names of an archive.
+func (c Charset) Decode(s string) (string, error) {
+    d, _, ok := c.decode(s)
+    if !ok {
+        return "", ErrCharset
+    }
+    return d, nil
+}
+
+// decode converts s to UTF-8 and scores how typical of the character set
+// its multi-byte characters are.
+func (c Charset) decode(s string) (string, int, bool) {
+    switch c {
+    case CharsetNone:
+        return s, 0, true
+    case CharsetAuto:
+        return DetectCharset([]string{s}).decode(s)
+    case CharsetUTF8:
+        return s, 0, utf8.ValidString(s)
+    case CharsetCP437:
+        var sb strings.Builder
+        for i := 0; i < len(s); i++ {
+            if s[i] < 0x80 {
+                sb.WriteByte(s[i])
+            } else {
+                sb.WriteRune(cp437[s[i]-0x80])
+            }
+        }
+        return sb.String(), 0, true
+    case CharsetGBK:
+        return gbk.decode(s)
+    case CharsetShiftJIS:
+        return shiftJIS.decode(s)
+    case CharsetBig5:
+        return big5.decode(s)
+    }
+    return "", 0, false
+}
+
+// DetectCharset guesses the character set of a set of names. Names are
+// taken as UTF-8 if they all are valid UTF-8; otherwise the East Asian
+// character set in which they decode to the most common characters is
+// chosen, falling back to CP437, the original IBM PC character set that
+// zip archives without the language encoding flag are meant to use.
+func DetectCharset(names []string) Charset {
+    var raw []string
+    for _, name := range names {
+        if !utf8.ValidString(name) {
+            raw = append(raw, name)
+        }
+    }
+    if len(raw) == 0 {
+        return CharsetUTF8
+    }
+    best, bestScore := CharsetCP437, 0
+    // On a tie the earlier candidate wins.
+    for _, c := range []Charset{CharsetGBK, CharsetBig5, CharsetShiftJIS} {
+        score := 0
+        for _, name := range raw {
+            _, s, ok := c.decode(name)
+            if !ok {
+                score = -1
+                break
+            }
+            score += s
+        }
+        if score > bestScore {
+            best, bestScore = c, score
+        }
+    }
+    return best
+}
+
+// DecodeZipNames rewrites in UTF-8 the names and comments of the entries
+// of zr that were stored without the language encoding flag. An Info-ZIP
+// Unicode Path or Comment extra field written for the stored text is used
+// in preference to decoding it with cs, and is then removed. With
+// CharsetAuto the character set is detected from all the names that need
+// decoding. DecodeZipNames returns the character set used.
+func DecodeZipNames(zr *zip.Reader, cs Charset) Charset {
+    if cs == CharsetAuto {
+        var names []string
+        for _, f := range zr.File {
+            if _, ok := unicodeExtra(f.Extra, unicodePathExtraID, f.Name); f.NonUTF8 && !ok {
+                names = append(names, f.Name)
+            }
+        }
+        cs = DetectCharset(names)
+    }
+    for _, f := range zr.File {
+        decodeZipName(&f.FileHeader, cs)
+    }
+    return cs
+}
+
+// decodeZipName rewrites the name and comment of fh in UTF-8.
+func decodeZipName(fh *zip.FileHeader, cs Charset) {
+    name, comment := fh.Name, fh.Comment
+    if s, ok := unicodeExtra(fh.Extra, unicodePathExtraID, fh.Name); ok {
+        fh.Name, fh.NonUTF8 = s, false
+    } else if fh.NonUTF8 && cs != CharsetNone {
+        if s, err := cs.Decode(fh.Name); err == nil {
+            fh.Name, fh.NonUTF8 = s, false
+        }
+    }
+    if s, ok := unicodeExtra(fh.Extra, unicodeCommentExtraID, fh.Comment); ok {
+        fh.Comment = s
+    } else if fh.Flags&0x800 == 0 && !utf8.ValidString(fh.Comment) && cs != CharsetNone {
+        if s, err := cs.Decode(fh.Comment); err == nil {
+            fh.Comment = s
+        }
+    }
+    if fh.Name != name || fh.Comment != comment {
+        // The fields describe the text as it was stored.
+        fh.Extra = stripExtra(fh.Extra, unicodePathExtraID, unicodeCommentExtraID)
+    }
+}
+
+// unicodeExtra returns the text of an Info-ZIP Unicode extra field with
+// the given ID, if extra holds one written for stored, the text in the
+// header.
+func unicodeExtra(extra []byte, id uint16, stored string) (string, bool) {
+    var text string
+    var ok bool
+    forEachExtra(extra, func(fid uint16, field []byte) {
+        if fid != id || len(field) < 5 || field[0] != 1 {
+            return
+        }
+        if binary.LittleEndian.Uint32(field[1:]) != crc32.ChecksumIEEE([]byte(stored)) {
+            return
+        }
+        if s := string(field[5:]); utf8.ValidString(s) {
+            text, ok = s, true
+        }
+    })
+    return text, ok
+}
+
+// decodeNames rewrites the names of hdr that are not valid UTF-8 in cs.
+func decodeNames(hdr *tar.Header, cs Charset) {
+    for _, p := range []*string{&hdr.Name, &hdr.Linkname} {
+        if utf8.ValidString(*p) {
+            continue
+        }
+        if s, err := cs.Decode(*p); err == nil {
+            *p = s
+        }
+    }
+}
+
+// setUTF8Flag prepares fh for zip.Writer, which sets the language
+// encoding flag for non-ASCII UTF-8 text but keeps a flag copied from
+// another header even when the text is not UTF-8.
+func setUTF8Flag(fh *zip.FileHeader) {
+    if !utf8.ValidString(fh.Name) || !utf8.ValidString(fh.Comment) {
+        fh.NonUTF8 = true
+    }
+}
+
+// cp437 maps the bytes 0x80 to 0xFF of code page 437.
+var cp437 = []rune("ÇüéâäàåçêëèïîìÄÅÉæÆôöòûùÿÖÜ¢£¥₧ƒáíóúñÑªº¿⌐¬½¼¡«»" +
+    "░▒▓│┤╡╢╖╕╣║╗╝╜╛┐└┴┬├─┼╞╟╚╔╩╦╠═╬╧╨╤╥╙╘╒╓╫╪┘┌█▄▌▐▀" +
+    "αßΓπΣσµτΦΘΩδ∞φε∩≡±≥≤⌠⌡÷≈°∙·√ⁿ²■\u00a0")
+
+// dbcs is a double-byte character set whose lead and trail bytes fall in
+// fixed ranges. Its table is decoded from data on first use.
+type dbcs struct {
+    data                             string
+    leadLo, leadHi, trailLo, trailHi byte
+    // kana reports whether 0xA1 to 0xDF are single-byte half-width
+    // katakana, as in Shift-JIS.
+    kana bool
+    // weight scores how common the character with the given bytes is.
+    weight func(lead, trail byte) int
+
+    once  sync.Once
+    table []uint16
+}
+
+var gbk = &dbcs{data: gbkData, leadLo: 0x81, leadHi: 0xfe, trailLo: 0x40, trailHi: 0xfe,
+    weight: func(lead, trail byte) int {
+        switch {
+        case trail < 0xa1:
+            return 0
+        case lead >= 0xb0 && lead <= 0xd7: // GB2312 level 1 hanzi
+            return 2
+        case lead >= 0xd8 && lead <= 0xf7: // GB2312 level 2 hanzi
+            return 1
+        }
+        return 0
+    }}
+
+var shiftJIS = &dbcs{data: shiftJISData, leadLo: 0x81, leadHi: 0xfc, trailLo: 0x40, trailHi: 0xfc, kana: true,
+    weight: func(lead, trail byte) int {
+        switch {
+        case lead == 0x82 || lead == 0x83: // hiragana and katakana
+            return 2
+        case lead >= 0x88 && lead <= 0x98: // JIS level 1 kanji
+            return 2
+        case lead >= 0x99 && lead <= 0x9f || lead >= 0xe0 && lead <= 0xea: // JIS level 2 kanji
+            return 1
+        }
+        return 0
+    }}
+
+var big5 = &dbcs{data: big5Data, leadLo: 0x81, leadHi: 0xfe, trailLo: 0x40, trailHi: 0xfe,
+    weight: func(lead, trail byte) int {
+        switch {
+        case lead >= 0xa4 && lead <= 0xc6: // frequently used hanzi
+            return 2
+        case lead >= 0xc9 && lead <= 0xf9: // less frequently used hanzi
+            return 1
+        }
+        return 0
+    }}
+
+func (d *dbcs) load() []uint16 {
+    d.once.Do(func() {
+        r := flate.NewReader(base64.NewDecoder(base64.StdEncoding, strings.NewReader(d.data)))
+        b, err := ioutil.ReadAll(r)
+        if err != nil {
+            panic("archive: corrupt character set table: " + err.Error())
+        }
+        d.table = make([]uint16, len(b)/2)
+        var v uint16
+        for i := range d.table {
+            v += binary.LittleEndian.Uint16(b[2*i:])
+            d.table[i] = v
+        }
+    })
+    return d.table
+}
+
+func (d *dbcs) decode(s string) (string, int, bool) {
+    table := d.load()
+    stride := int(d.trailHi-d.trailLo) + 1
+    var sb strings.Builder
+    score := 0
+    for i := 0; i < len(s); i++ {
+        b := s[i]
+        switch {
+        case b < 0x80:
+            sb.WriteByte(b)
+            continue
+        case d.kana && b >= 0xa1 && b <= 0xdf:
+            sb.WriteRune(0xff61 + rune(b-0xa1))
+            continue
+        }
+        if i+1 == len(s) || b < d.leadLo || b > d.leadHi {
+            return "", 0, false
+        }
+        t := s[i+1]
+        if t < d.trailLo || t > d.trailHi {
+            return "", 0, false
+        }
+        r := table[int(b-d.leadLo)*stride+int(t-d.trailLo)]
+        if r == 0 {
+            return "", 0, false
+        }
+        sb.WriteRune(rune(r))
+        score += d.weight(b, t)
+        i++
+    }
+    return sb.String(), score, true
+}
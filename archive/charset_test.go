@@ -0,0 +1,245 @@
+package archive
+
+import (
+    "archive/tar"
+    "archive/zip"
+    "bytes"
+    "context"
+    "encoding/binary"
+    "hash/crc32"
+    "io"
+    "testing"
+)
+
+const (
+    gbkDir     = "\xd6\xd0\xce\xc4\xc4\xbf\xc2\xbc/\xb2\xe2\xca\xd4\xce\xc4\xbc\xfe.txt" // 中文目录/测试文件.txt
+    gbkReport  = "\xb1\xa8\xb8\xe6.doc"                                                  // 报告.doc
+    sjisDir    = "\x93\xfa\x96{\x8c\xea\x83t\x83H\x83\x8b\x83_/\x83e\x83X\x83g.txt"      // 日本語フォルダ/テスト.txt
+    sjisData   = "\x8e\x91\x97\xbf.xls"                                                  // 資料.xls
+    big5Dir    = "\xc1c\xc5\xe9\xa4\xa4\xa4\xe5/\xb4\xfa\xb8\xd5\xc0\xc9\xae\xd7.txt"    // 繁體中文/測試檔案.txt
+    big5Report = "\xb3\xf8\xa7i.doc"                                                     // 報告.doc
+)
+
+func TestCharsetDecode(t *testing.T) {
+    for _, tt := range []struct {
+        cs       Charset
+        raw, out string
+    }{
+        {CharsetGBK, gbkDir, "中文目录/测试文件.txt"},
+        {CharsetShiftJIS, sjisDir, "日本語フォルダ/テスト.txt"},
+        {CharsetShiftJIS, "\xb1\xb2.txt", "ｱｲ.txt"},
+        {CharsetBig5, big5Dir, "繁體中文/測試檔案.txt"},
+        {CharsetCP437, "Gr\x94\xe1e.txt", "Größe.txt"},
+        {CharsetUTF8, "plain.txt", "plain.txt"},
+    } {
+        got, err := tt.cs.Decode(tt.raw)
+        if err != nil || got != tt.out {
+            t.Errorf("%v.Decode(%q) = %q, %v, want %q", tt.cs, tt.raw, got, err, tt.out)
+        }
+    }
+    for _, tt := range []struct {
+        cs  Charset
+        raw string
+    }{
+        {CharsetGBK, "\xb1"},
+        {CharsetGBK, "\xff\xa1"},
+        {CharsetBig5, "\xa4\x30"},
+        {CharsetUTF8, gbkReport},
+    } {
+        if _, err := tt.cs.Decode(tt.raw); err != ErrCharset {
+            t.Errorf("%v.Decode(%q): err = %v, want ErrCharset", tt.cs, tt.raw, err)
+        }
+    }
+    if len(cp437) != 128 {
+        t.Errorf("cp437 has %d entries", len(cp437))
+    }
+    for _, cs := range []Charset{CharsetNone, CharsetAuto, CharsetUTF8, CharsetCP437, CharsetGBK, CharsetShiftJIS, CharsetBig5} {
+        if got, err := ParseCharset(cs.String()); err != nil || got != cs {
+            t.Errorf("ParseCharset(%q) = %v, %v", cs, got, err)
+        }
+    }
+}
+
+func TestDetectCharset(t *testing.T) {
+    for _, tt := range []struct {
+        names []string
+        want  Charset
+    }{
+        {[]string{"a.txt", "目录/文件.txt"}, CharsetUTF8},
+        {[]string{gbkDir, gbkReport, "readme.txt"}, CharsetGBK},
+        {[]string{gbkReport}, CharsetGBK},
+        {[]string{sjisDir, sjisData}, CharsetShiftJIS},
+        {[]string{sjisData}, CharsetShiftJIS},
+        {[]string{big5Dir, big5Report}, CharsetBig5},
+        {[]string{big5Report}, CharsetBig5},
+        {[]string{"Gr\x94\xe1e.txt", "caf\x82"}, CharsetCP437},
+    } {
+        if got := DetectCharset(tt.names); got != tt.want {
+            t.Errorf("DetectCharset(%q) = %v, want %v", tt.names, got, tt.want)
+        }
+    }
+}
+
+// unicodePathExtra returns an Info-ZIP Unicode Path extra field giving
+// name for an entry stored as stored.
+func unicodePathExtra(stored, name string) []byte {
+    b := make([]byte, 9, 9+len(name))
+    binary.LittleEndian.PutUint16(b, unicodePathExtraID)
+    binary.LittleEndian.PutUint16(b[2:], uint16(5+len(name)))
+    b[4] = 1
+    binary.LittleEndian.PutUint32(b[5:], crc32.ChecksumIEEE([]byte(stored)))
+    return append(b, name...)
+}
+
+// legacyZip returns a zip archive whose names are stored as given,
+// without the language encoding flag.
+func legacyZip(t *testing.T, files map[string]string, extra map[string][]byte) []byte {
+    var buf bytes.Buffer
+    zw := zip.NewWriter(&buf)
+    for name, body := range files {
+        fw, err := zw.CreateHeader(&zip.FileHeader{Name: name, Method: zip.Store, NonUTF8: true, Extra: extra[name]})
+        if err != nil {
+            t.Fatal(err)
+        }
+        io.WriteString(fw, body)
+    }
+    if err := zw.Close(); err != nil {
+        t.Fatal(err)
+    }
+    return buf.Bytes()
+}
+
+func TestExtractZipCharset(t *testing.T) {
+    b := legacyZip(t, map[string]string{
+        gbkDir:      "gbk",
+        gbkReport:   "report",
+        "file?.txt": "unicode path",
+    }, map[string][]byte{"file?.txt": unicodePathExtra("file?.txt", "файл.txt")})
+
+    dst := tempDir(t)
+    if err := ExtractZip(context.Background(), bytes.NewReader(b), int64(len(b)), dst, &ExtractOptions{Charset: CharsetAuto}); err != nil {
+        t.Fatal(err)
+    }
+    checkTree(t, dst, map[string]string{
+        "中文目录/测试文件.txt": "gbk",
+        "报告.doc":        "report",
+        "файл.txt":      "unicode path",
+    })
+
+    zr, err := zip.NewReader(bytes.NewReader(b), int64(len(b)))
+    if err != nil {
+        t.Fatal(err)
+    }
+    if cs := DecodeZipNames(zr, CharsetNone); cs != CharsetNone {
+        t.Errorf("DecodeZipNames returned %v", cs)
+    }
+    for _, f := range zr.File {
+        if f.Name == "файл.txt" && len(f.Extra) != 0 {
+            t.Errorf("stale Unicode Path extra field kept: %x", f.Extra)
+        }
+        if f.Name == gbkReport && !f.NonUTF8 {
+            t.Errorf("%q decoded without a character set", f.Name)
+        }
+    }
+
+    zs := NewZipStreamReader(bytes.NewReader(b))
+    zs.SetCharset(CharsetGBK)
+    var names []string
+    for {
+        fh, err := zs.Next()
+        if err == io.EOF {
+            break
+        }
+        if err != nil {
+            t.Fatal(err)
+        }
+        names = append(names, fh.Name)
+    }
+    if len(names) != 3 || len(zs.Central()) != 3 {
+        t.Fatalf("stream names = %q", names)
+    }
+    for _, fh := range zs.Central() {
+        if fh.NonUTF8 {
+            t.Errorf("central name %q not decoded", fh.Name)
+        }
+    }
+}
+
+func TestExtractTarCharset(t *testing.T) {
+    var buf bytes.Buffer
+    tw := tar.NewWriter(&buf)
+    for _, hdr := range []*tar.Header{
+        {Name: sjisDir, Typeflag: tar.TypeReg, Mode: 0644, Size: 4, Format: tar.FormatGNU},
+        {Name: sjisData, Typeflag: tar.TypeLink, Linkname: sjisDir, Format: tar.FormatGNU},
+    } {
+        if err := tw.WriteHeader(hdr); err != nil {
+            t.Fatal(err)
+        }
+        if hdr.Size > 0 {
+            io.WriteString(tw, "sjis")
+        }
+    }
+    tw.Close()
+    dst := tempDir(t)
+    if err := ExtractTar(context.Background(), &buf, dst, &ExtractOptions{Charset: CharsetShiftJIS}); err != nil {
+        t.Fatal(err)
+    }
+    checkTree(t, dst, map[string]string{"日本語フォルダ/テスト.txt": "sjis", "資料.xls": "sjis"})
+}
+
+func TestZipUTF8Flag(t *testing.T) {
+    var buf bytes.Buffer
+    zw := NewZipStreamWriter(&buf)
+    for _, fh := range []*zip.FileHeader{
+        {Name: "测试.txt"},
+        {Name: gbkReport, Flags: 0x800},
+        {Name: "plain.txt"},
+    } {
+        if _, err := zw.CreateHeader(fh); err != nil {
+            t.Fatal(err)
+        }
+    }
+    zw.Close()
+    zr, err := zip.NewReader(bytes.NewReader(buf.Bytes()), int64(buf.Len()))
+    if err != nil {
+        t.Fatal(err)
+    }
+    for i, want := range []bool{true, false, false} {
+        if f := zr.File[i]; f.Flags&0x800 != 0 != want {
+            t.Errorf("%q: flags %#x", f.Name, f.Flags)
+        }
+    }
+}
+
+func TestNFC(t *testing.T) {
+    for in, want := range map[string]string{
+        "cafe\u0301":             "caf\u00e9",
+        "\u212b":                 "\u00c5",       // singleton decomposition
+        "a\u0323\u0302":          "\u1ead",       // canonical order
+        "a\u0302\u0323":          "\u1ead",       // reordered
+        "\u0915\u093c":           "\u0915\u093c", // composition exclusion
+        "\u1100\u1161\u11a8":     "\uac01",       // Hangul
+        "e\u0301\u0301":          "\u00e9\u0301", // blocked second accent
+        "ASCII only":             "ASCII only",
+        "A\u030a\u0301abc\u0301": "\u01faab\u0107",
+    } {
+        if got := nfc(in); got != want {
+            t.Errorf("nfc(%+q) = %+q, want %+q", in, got, want)
+        }
+    }
+}
+
+func TestExtractNormalizeNames(t *testing.T) {
+    b := zipBytes(t, map[string][]byte{
+        "cafe\u0301/menu.txt": []byte("decomposed"),
+        "caf\u00e9/wine.txt":  []byte("composed"),
+    })
+    dst := tempDir(t)
+    if err := ExtractZip(context.Background(), bytes.NewReader(b), int64(len(b)), dst, &ExtractOptions{NormalizeNames: true}); err != nil {
+        t.Fatal(err)
+    }
+    checkTree(t, dst, map[string]string{
+        "caf\u00e9/menu.txt": "decomposed",
+        "caf\u00e9/wine.txt": "composed",
+    })
+}
@@ -0,0 +1,476 @@
+package archive
+
+import (
+    "archive/tar"
+    "bytes"
+    "errors"
+    "fmt"
+    "io"
+    "io/ioutil"
+    "strconv"
+    "time"
+)
+
+// CpioFormat selects a cpio header format.
+type CpioFormat int
+
+const (
+    // CpioNewc is the SVR4 format with hex fields, used by initramfs.
+    CpioNewc CpioFormat = iota
+    // CpioCRC is CpioNewc with a checksum of the member data.
+    CpioCRC
+    // CpioODC is the POSIX.1 portable format with octal fields.
+    CpioODC
+)
+
+const (
+    cpioNewcMagic = "070701"
+    cpioCRCMagic  = "070702"
+    cpioODCMagic  = "070707"
+    cpioTrailer   = "TRAILER!!!"
+
+    cpioNewcLen = 110
+    cpioODCLen  = 76
+)
+
+// File type bits of the cpio mode field.
+const (
+    cpioTypeMask = 0170000
+    cpioSocket   = 0140000
+    cpioSymlink  = 0120000
+    cpioRegular  = 0100000
+    cpioBlock    = 0060000
+    cpioDir      = 0040000
+    cpioChar     = 0020000
+    cpioFifo     = 0010000
+)
+
+var (
+    errCpioHeader = errors.New("archive: invalid cpio header")
+    // ErrCpioChecksum is returned when a CpioCRC member fails verification.
+    ErrCpioChecksum = errors.New("archive: cpio checksum mismatch")
+)
+
+// CpioReader reads a cpio archive in newc, crc or odc format; the format
+// of each header is recognized from its magic number. Members are
+// presented with the tar entry model, like tar.Reader. Symlink targets are
+// returned in Linkname. Hard links are returned as separate regular
+// members, as they are stored.
+type CpioReader struct {
+    r      io.Reader
+    remain int64
+    pad    int64
+    check  uint32 // expected checksum, for CpioCRC members
+    sum    uint32
+    crc    bool
+    err    error
+}
+
+// NewCpioReader returns a reader for the cpio archive in r.
+func NewCpioReader(r io.Reader) *CpioReader {
+    return &CpioReader{r: r}
+}
+
+// Next advances to the next member. It returns io.EOF at the trailer.
+func (cr *CpioReader) Next() (*tar.Header, error) {
+    if cr.err != nil {
+        return nil, cr.err
+    }
+    hdr, err := cr.next()
+    if err != nil {
+        cr.err = err
+    }
+    return hdr, err
+}
+
+func (cr *CpioReader) next() (*tar.Header, error) {
+    if cr.remain > 0 {
+        if _, err := io.Copy(ioutil.Discard, cr); err != nil {
+            return nil, err
+        }
+    }
+    if _, err := io.CopyN(ioutil.Discard, cr.r, cr.pad); err != nil {
+        return nil, unexpected(err)
+    }
+    cr.pad = 0
+    var magic [6]byte
+    if _, err := io.ReadFull(cr.r, magic[:]); err != nil {
+        if err == io.ErrUnexpectedEOF {
+            return nil, errCpioHeader
+        }
+        return nil, err
+    }
+    var (
+        f        cpioFields
+        nameSize int64
+        err      error
+    )
+    switch string(magic[:]) {
+    case cpioNewcMagic, cpioCRCMagic:
+        f, nameSize, err = cr.readNewc()
+        cr.crc = string(magic[:]) == cpioCRCMagic
+    case cpioODCMagic:
+        f, nameSize, err = cr.readODC()
+        cr.crc = false
+    default:
+        return nil, errCpioHeader
+    }
+    if err != nil {
+        return nil, err
+    }
+    if nameSize < 1 || nameSize > 1<<20 {
+        return nil, errCpioHeader
+    }
+    name := make([]byte, nameSize)
+    if _, err := io.ReadFull(cr.r, name); err != nil {
+        return nil, unexpected(err)
+    }
+    name = bytes.TrimRight(name, "\x00")
+    newc := string(magic[:]) != cpioODCMagic
+    if newc {
+        if _, err := io.CopyN(ioutil.Discard, cr.r, pad4(cpioNewcLen+nameSize)); err != nil {
+            return nil, unexpected(err)
+        }
+    }
+    if string(name) == cpioTrailer {
+        return nil, io.EOF
+    }
+
+    hdr := &tar.Header{
+        Name:     string(name),
+        Mode:     f.mode & 07777,
+        Uid:      int(f.uid),
+        Gid:      int(f.gid),
+        Size:     f.size,
+        ModTime:  time.Unix(f.mtime, 0),
+        Devmajor: f.rdevmajor,
+        Devminor: f.rdevminor,
+    }
+    cr.remain, cr.check, cr.sum = f.size, f.check, 0
+    if newc {
+        cr.pad = pad4(f.size)
+    }
+    switch f.mode & cpioTypeMask {
+    case cpioRegular:
+        hdr.Typeflag = tar.TypeReg
+    case cpioDir:
+        hdr.Typeflag = tar.TypeDir
+    case cpioSymlink:
+        hdr.Typeflag = tar.TypeSymlink
+        target, err := ioutil.ReadAll(io.LimitReader(cr, 4096+1))
+        if err != nil {
+            return nil, err
+        }
+        if len(target) > 4096 {
+            return nil, errCpioHeader
+        }
+        hdr.Linkname = string(target)
+    case cpioChar:
+        hdr.Typeflag = tar.TypeChar
+    case cpioBlock:
+        hdr.Typeflag = tar.TypeBlock
+    case cpioFifo:
+        hdr.Typeflag = tar.TypeFifo
+    default:
+        // Sockets and unknown types are passed through as regular members.
+        hdr.Typeflag = tar.TypeReg
+    }
+    if hdr.Typeflag != tar.TypeReg {
+        hdr.Size = 0
+    }
+    return hdr, nil
+}
+
+// cpioFields are the numeric header fields common to all formats.
+type cpioFields struct {
+    ino, mode, uid, gid, nlink int64
+    mtime, size                int64
+    rdevmajor, rdevminor       int64
+    check                      uint32
+}
+
+func (cr *CpioReader) readNewc() (cpioFields, int64, error) {
+    var buf [cpioNewcLen - 6]byte
+    if _, err := io.ReadFull(cr.r, buf[:]); err != nil {
+        return cpioFields{}, 0, unexpected(err)
+    }
+    var v [13]int64
+    for i := range v {
+        n, err := strconv.ParseUint(string(buf[i*8:i*8+8]), 16, 32)
+        if err != nil {
+            return cpioFields{}, 0, errCpioHeader
+        }
+        v[i] = int64(n)
+    }
+    f := cpioFields{
+        ino: v[0], mode: v[1], uid: v[2], gid: v[3], nlink: v[4],
+        mtime: v[5], size: v[6], rdevmajor: v[9], rdevminor: v[10],
+        check: uint32(v[12]),
+    }
+    return f, v[11], nil
+}
+
+func (cr *CpioReader) readODC() (cpioFields, int64, error) {
+    var buf [cpioODCLen - 6]byte
+    if _, err := io.ReadFull(cr.r, buf[:]); err != nil {
+        return cpioFields{}, 0, unexpected(err)
+    }
+    widths := []int{6, 6, 6, 6, 6, 6, 6, 11, 6, 11}
+    var v [10]int64
+    off := 0
+    for i, w := range widths {
+        n, err := strconv.ParseUint(string(buf[off:off+w]), 8, 64)
+        if err != nil {
+            return cpioFields{}, 0, errCpioHeader
+        }
+        v[i] = int64(n)
+        off += w
+    }
+    f := cpioFields{
+        ino: v[1], mode: v[2], uid: v[3], gid: v[4], nlink: v[5],
+        rdevmajor: v[6] >> 8, rdevminor: v[6] & 0xff,
+        mtime: v[7], size: v[9],
+    }
+    return f, v[8], nil
+}
+
+// Read reads from the current member.
+func (cr *CpioReader) Read(p []byte) (int, error) {
+    if cr.remain <= 0 {
+        return 0, io.EOF
+    }
+    if int64(len(p)) > cr.remain {
+        p = p[:cr.remain]
+    }
+    n, err := cr.r.Read(p)
+    cr.remain -= int64(n)
+    if cr.crc {
+        for _, c := range p[:n] {
+            cr.sum += uint32(c)
+        }
+    }
+    if cr.remain == 0 {
+        if cr.crc && cr.sum != cr.check {
+            return n, ErrCpioChecksum
+        }
+        return n, io.EOF
+    }
+    if err == io.EOF {
+        err = io.ErrUnexpectedEOF
+    }
+    return n, err
+}
+
+func pad4(n int64) int64 { return (4 - n%4) % 4 }
+
+// CpioWriter writes a cpio archive.
+type CpioWriter struct {
+    w      io.Writer
+    format CpioFormat
+    ino    int64
+    hdr    *tar.Header
+    remain int64
+    buf    bytes.Buffer // member data, buffered for CpioCRC checksums
+    err    error
+}
+
+// NewCpioWriter returns a writer producing a cpio archive in the given
+// format. In CpioCRC format each member is buffered in memory, since its
+// checksum precedes the data.
+func NewCpioWriter(w io.Writer, format CpioFormat) *CpioWriter {
+    return &CpioWriter{w: w, format: format}
+}
+
+// WriteHeader begins a new member. Symlink targets are taken from
+// hdr.Linkname. Hard links are not supported.
+func (cw *CpioWriter) WriteHeader(hdr *tar.Header) error {
+    if cw.err != nil {
+        return cw.err
+    }
+    if err := cw.flush(); err != nil {
+        return err
+    }
+    h := *hdr
+    var mode int64
+    switch h.Typeflag {
+    case tar.TypeReg, tar.TypeRegA:
+        mode = cpioRegular
+    case tar.TypeDir:
+        mode, h.Size = cpioDir, 0
+    case tar.TypeSymlink:
+        mode, h.Size = cpioSymlink, int64(len(h.Linkname))
+    case tar.TypeChar:
+        mode, h.Size = cpioChar, 0
+    case tar.TypeBlock:
+        mode, h.Size = cpioBlock, 0
+    case tar.TypeFifo:
+        mode, h.Size = cpioFifo, 0
+    default:
+        return fmt.Errorf("archive: cpio cannot store %s: type %q", h.Name, h.Typeflag)
+    }
+    h.Mode = h.Mode&07777 | mode
+    cw.ino++
+    cw.hdr, cw.remain = &h, h.Size
+    if cw.format != CpioCRC {
+        if err := cw.writeHeader(&h, 0); err != nil {
+            return err
+        }
+    }
+    if h.Typeflag == tar.TypeSymlink {
+        if _, err := io.WriteString(cw, h.Linkname); err != nil {
+            return err
+        }
+    }
+    return nil
+}
+
+func (cw *CpioWriter) writeHeader(h *tar.Header, check uint32) error {
+    name := h.Name
+    mtime := h.ModTime.Unix()
+    if h.ModTime.IsZero() || mtime < 0 {
+        mtime = 0
+    }
+    nlink := 1
+    if h.Typeflag == tar.TypeDir {
+        nlink = 2
+    }
+    var s string
+    switch cw.format {
+    case CpioNewc, CpioCRC:
+        magic := cpioNewcMagic
+        if cw.format == CpioCRC {
+            magic = cpioCRCMagic
+        }
+        s = fmt.Sprintf("%s%08x%08x%08x%08x%08x%08x%08x%08x%08x%08x%08x%08x%08x",
+            magic, cw.ino, h.Mode, h.Uid, h.Gid, nlink, mtime, h.Size,
+            0, 0, h.Devmajor, h.Devminor, len(name)+1, check)
+        if len(s) != cpioNewcLen {
+            return fmt.Errorf("archive: cpio header field overflow for %s", name)
+        }
+        s += name + "\x00"
+        s += string(make([]byte, pad4(int64(len(s)))))
+    case CpioODC:
+        rdev := h.Devmajor<<8 | h.Devminor
+        s = fmt.Sprintf("%s%06o%06o%06o%06o%06o%06o%06o%011o%06o%011o",
+            cpioODCMagic, 0, cw.ino&0777777, h.Mode, h.Uid, h.Gid, nlink, rdev, mtime, len(name)+1, h.Size)
+        s += name + "\x00"
+        if len(s) != cpioODCLen+len(name)+1 {
+            return fmt.Errorf("archive: cpio header field overflow for %s", name)
+        }
+    }
+    _, err := io.WriteString(cw.w, s)
+    if err != nil {
+        cw.err = err
+    }
+    return err
+}
+
+// Write writes to the current member.
+func (cw *CpioWriter) Write(p []byte) (int, error) {
+    if cw.err != nil {
+        return 0, cw.err
+    }
+    if int64(len(p)) > cw.remain {
+        return 0, errors.New("archive: write too long")
+    }
+    cw.remain -= int64(len(p))
+    if cw.format == CpioCRC {
+        return cw.buf.Write(p)
+    }
+    n, err := cw.w.Write(p)
+    if err != nil {
+        cw.err = err
+    }
+    return n, err
+}
+
+// flush completes the current member.
+func (cw *CpioWriter) flush() error {
+    if cw.hdr == nil {
+        return nil
+    }
+    if cw.remain > 0 {
+        cw.err = errors.New("archive: missed writing member data")
+        return cw.err
+    }
+    if cw.format == CpioCRC {
+        var sum uint32
+        for _, c := range cw.buf.Bytes() {
+            sum += uint32(c)
+        }
+        if err := cw.writeHeader(cw.hdr, sum); err != nil {
+            return err
+        }
+        if _, err := cw.w.Write(cw.buf.Bytes()); err != nil {
+            cw.err = err
+            return err
+        }
+        cw.buf.Reset()
+    }
+    if cw.format != CpioODC {
+        if _, err := cw.w.Write(make([]byte, pad4(cw.hdr.Size))); err != nil {
+            cw.err = err
+            return err
+        }
+    }
+    cw.hdr = nil
+    return nil
+}
+
+// Close writes the trailer. It does not close the underlying writer.
+func (cw *CpioWriter) Close() error {
+    if cw.err != nil {
+        return cw.err
+    }
+    if err := cw.flush(); err != nil {
+        return err
+    }
+    cw.ino = 0
+    if err := cw.writeHeader(&tar.Header{Name: cpioTrailer}, 0); err != nil {
+        return err
+    }
+    cw.err = errors.New("archive: write after close")
+    return nil
+}
+
+// CpioReadWrite builds a small initramfs-style cpio archive and lists it.
+func CpioReadWrite() {
+    var buf bytes.Buffer
+    cw := NewCpioWriter(&buf, CpioNewc)
+    var files = []struct {
+        Name, Body string
+    }{
+        {"init", "#!/bin/sh\nexec /bin/sh\n"},
+        {"etc/hostname", "gopher\n"},
+    }
+    cw.WriteHeader(&tar.Header{Name: "etc", Typeflag: tar.TypeDir, Mode: 0755})
+    for _, file := range files {
+        hdr := &tar.Header{Name: file.Name, Typeflag: tar.TypeReg, Mode: 0755, Size: int64(len(file.Body))}
+        if err := cw.WriteHeader(hdr); err != nil {
+            fmt.Println(err)
+            return
+        }
+        if _, err := cw.Write([]byte(file.Body)); err != nil {
+            fmt.Println(err)
+            return
+        }
+    }
+    if err := cw.Close(); err != nil {
+        fmt.Println(err)
+        return
+    }
+
+    cr := NewCpioReader(&buf)
+    for {
+        hdr, err := cr.Next()
+        if err == io.EOF {
+            break
+        }
+        if err != nil {
+            fmt.Println(err)
+            return
+        }
+        body, _ := ioutil.ReadAll(cr)
+        fmt.Printf("Contents of %s: %q\n", hdr.Name, body)
+    }
+}
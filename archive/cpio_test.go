@@ -0,0 +1,116 @@
+package archive
+
+import (
+    "archive/tar"
+    "bytes"
+    "context"
+    "io"
+    "io/ioutil"
+    "path/filepath"
+    "strings"
+    "testing"
+    "time"
+)
+
+func TestCpioReadWrite(t *testing.T) {
+    CpioReadWrite()
+}
+
+func TestCpioFormats(t *testing.T) {
+    mtime := time.Unix(1600000000, 0)
+    entries := []struct {
+        hdr  tar.Header
+        body string
+    }{
+        {tar.Header{Name: "etc", Typeflag: tar.TypeDir, Mode: 0755}, ""},
+        {tar.Header{Name: "etc/hostname", Typeflag: tar.TypeReg, Mode: 0644, Size: 7}, "gopher\n"},
+        {tar.Header{Name: "bin/sh", Typeflag: tar.TypeSymlink, Linkname: "busybox", Mode: 0777}, ""},
+        {tar.Header{Name: "dev/console", Typeflag: tar.TypeChar, Mode: 0600, Devmajor: 5, Devminor: 1}, ""},
+        {tar.Header{Name: "init", Typeflag: tar.TypeReg, Mode: 0755, Size: 10}, "#!/bin/sh\n"},
+    }
+    for _, format := range []CpioFormat{CpioNewc, CpioCRC, CpioODC} {
+        var buf bytes.Buffer
+        cw := NewCpioWriter(&buf, format)
+        for _, e := range entries {
+            hdr := e.hdr
+            hdr.ModTime, hdr.Uid, hdr.Gid = mtime, 1, 2
+            if err := cw.WriteHeader(&hdr); err != nil {
+                t.Fatal(err)
+            }
+            io.WriteString(cw, e.body)
+        }
+        if err := cw.Close(); err != nil {
+            t.Fatal(err)
+        }
+
+        cr := NewCpioReader(bytes.NewReader(buf.Bytes()))
+        for _, e := range entries {
+            hdr, err := cr.Next()
+            if err != nil {
+                t.Fatalf("format %d: %v", format, err)
+            }
+            body, err := ioutil.ReadAll(cr)
+            if err != nil {
+                t.Fatal(err)
+            }
+            if hdr.Name != e.hdr.Name || hdr.Typeflag != e.hdr.Typeflag || hdr.Mode != e.hdr.Mode ||
+                hdr.Linkname != e.hdr.Linkname || hdr.Devmajor != e.hdr.Devmajor || hdr.Devminor != e.hdr.Devminor ||
+                hdr.Uid != 1 || hdr.Gid != 2 || !hdr.ModTime.Equal(mtime) {
+                t.Errorf("format %d: got %+v, want %+v", format, hdr, e.hdr)
+            }
+            if string(body) != e.body {
+                t.Errorf("format %d: %s = %q", format, hdr.Name, body)
+            }
+        }
+        if _, err := cr.Next(); err != io.EOF {
+            t.Fatalf("format %d: final Next = %v", format, err)
+        }
+
+        if format == CpioCRC {
+            data := buf.Bytes()
+            i := bytes.Index(data, []byte("gopher"))
+            data[i] = 'G'
+            cr := NewCpioReader(bytes.NewReader(data))
+            var err error
+            for err == nil {
+                if _, err = cr.Next(); err == nil {
+                    _, err = ioutil.ReadAll(cr)
+                }
+            }
+            if err != ErrCpioChecksum {
+                t.Fatalf("corrupt crc member: %v", err)
+            }
+        }
+    }
+}
+
+func TestCpioLongSymlink(t *testing.T) {
+    // A symlink target is read into memory, so its length is bounded.
+    var buf bytes.Buffer
+    cw := NewCpioWriter(&buf, CpioNewc)
+    if err := cw.WriteHeader(&tar.Header{Name: "link", Typeflag: tar.TypeSymlink, Linkname: strings.Repeat("x", 5000), Mode: 0777}); err != nil {
+        t.Fatal(err)
+    }
+    cw.Close()
+    if _, err := NewCpioReader(&buf).Next(); err != errCpioHeader {
+        t.Fatalf("Next = %v", err)
+    }
+}
+
+func TestExtractCpio(t *testing.T) {
+    dir := tempDir(t)
+    var buf bytes.Buffer
+    cw := NewCpioWriter(&buf, CpioNewc)
+    cw.WriteHeader(&tar.Header{Name: "etc/hostname", Typeflag: tar.TypeReg, Mode: 0644, Size: 7})
+    io.WriteString(cw, "gopher\n")
+    cw.Close()
+    p := filepath.Join(dir, "initrd.cpio")
+    if err := ioutil.WriteFile(p, buf.Bytes(), 0644); err != nil {
+        t.Fatal(err)
+    }
+    dst := filepath.Join(dir, "out")
+    if err := Extract(context.Background(), p, dst, nil); err != nil {
+        t.Fatal(err)
+    }
+    checkTree(t, dst, map[string]string{"etc/hostname": "gopher\n"})
+}
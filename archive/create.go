@@ -0,0 +1,271 @@
+package archive
+
+import (
+    "archive/tar"
+    "archive/zip"
+    "compress/flate"
+    "context"
+    "io"
+    "os"
+    "path/filepath"
+    "strings"
+    "time"
+)
+
+// CreateOptions configures Create, WriteTar and WriteZip.
+type CreateOptions struct {
+    // Progress, if set, receives progress reports.
+    Progress ProgressFunc
+    // Compression, if set, chooses between Store and Deflate for each
+    // file written by WriteZip. Otherwise every file is deflated.
+    Compression *CompressionPolicy
+    // Align, if not zero, makes WriteZip start the content of stored
+    // entries at a multiple of Align bytes, a power of two, as zipalign
+    // does: 4 for word access, or os.Getpagesize() so that MappedZip can
+    // map them in place. Stored files then have their sizes and CRC in
+    // their local headers, and are read twice to get them.
+    Align int
+}
+
+// source is one file system object to be archived.
+type source struct {
+    path string // path on disk
+    name string // slash separated name inside the archive
+    info os.FileInfo
+}
+
+// walkSources lists the tree rooted at root in lexical order.
+func walkSources(ctx context.Context, root string) ([]source, int64, error) {
+    var srcs []source
+    var total int64
+    err := filepath.Walk(root, func(p string, info os.FileInfo, err error) error {
+        if err != nil {
+            return err
+        }
+        if err := ctx.Err(); err != nil {
+            return err
+        }
+        rel, err := filepath.Rel(root, p)
+        if err != nil {
+            return err
+        }
+        if rel == "." {
+            return nil
+        }
+        name := filepath.ToSlash(rel)
+        if info.IsDir() {
+            name += "/"
+        }
+        if info.Mode().IsRegular() {
+            total += info.Size()
+        }
+        srcs = append(srcs, source{path: p, name: name, info: info})
+        return nil
+    })
+    return srcs, total, err
+}
+
+// Create archives the directory tree at src into the file dst. The format
+// is chosen from the extension of dst. If the operation fails or ctx is
+// cancelled, the partial archive is removed.
+func Create(ctx context.Context, dst, src string, opts *CreateOptions) (err error) {
+    format := FormatFromName(dst)
+    if format == FormatUnknown {
+        return ErrFormat
+    }
+    f, err := os.Create(dst)
+    if err != nil {
+        return err
+    }
+    defer func() {
+        if cerr := f.Close(); err == nil {
+            err = cerr
+        }
+        if err != nil {
+            os.Remove(dst)
+        }
+    }()
+    if format == FormatZip {
+        return WriteZip(ctx, f, src, opts)
+    }
+    return WriteTar(ctx, f, src, opts)
+}
+
+// WriteTar writes the directory tree at src to w as a tar stream.
+func WriteTar(ctx context.Context, w io.Writer, src string, opts *CreateOptions) error {
+    if opts == nil {
+        opts = &CreateOptions{}
+    }
+    srcs, total, err := walkSources(ctx, src)
+    if err != nil {
+        return err
+    }
+    tk := newTracker(opts.Progress, len(srcs), total)
+    tw := tar.NewWriter(&ctxWriter{ctx: ctx, w: w, count: tk.addOut})
+    for _, s := range srcs {
+        tk.begin(s.name)
+        if err := writeTarSource(ctx, tw, s, tk); err != nil {
+            return err
+        }
+        tk.entryDone()
+    }
+    if err := tw.Close(); err != nil {
+        return err
+    }
+    tk.done()
+    return nil
+}
+
+func writeTarSource(ctx context.Context, tw *tar.Writer, s source, tk *tracker) error {
+    var link string
+    if s.info.Mode()&os.ModeSymlink != 0 {
+        var err error
+        if link, err = os.Readlink(s.path); err != nil {
+            return err
+        }
+    }
+    hdr, err := tar.FileInfoHeader(s.info, link)
+    if err != nil {
+        return err
+    }
+    hdr.Name = s.name
+    // Access and change times vary between otherwise identical trees.
+    hdr.AccessTime, hdr.ChangeTime = time.Time{}, time.Time{}
+    keepTarNanos(hdr)
+    if err := tw.WriteHeader(hdr); err != nil {
+        return err
+    }
+    if !s.info.Mode().IsRegular() {
+        return nil
+    }
+    return copyFile(ctx, tw, s.path, tk)
+}
+
+// WriteZip writes the directory tree at src to w as a zip archive.
+func WriteZip(ctx context.Context, w io.Writer, src string, opts *CreateOptions) error {
+    if opts == nil {
+        opts = &CreateOptions{}
+    }
+    srcs, total, err := walkSources(ctx, src)
+    if err != nil {
+        return err
+    }
+    if err := checkAlign(opts.Align); err != nil {
+        return err
+    }
+    tk := newTracker(opts.Progress, len(srcs), total)
+    level := flate.BestCompression
+    if opts.Compression != nil {
+        level = opts.Compression.level()
+    }
+    var a *zipAligner
+    cw := &ctxWriter{ctx: ctx, w: w, count: tk.addOut}
+    if opts.Align > 0 {
+        a = &zipAligner{align: opts.Align, level: level}
+        cw.count = func(n int64) {
+            a.off += n
+            tk.addOut(n)
+        }
+    }
+    zw := newZipWriter(cw)
+    if a != nil {
+        a.zw = zw
+    }
+    zw.RegisterCompressor(zip.Deflate, func(out io.Writer) (io.WriteCloser, error) {
+        return flate.NewWriter(out, level)
+    })
+    for _, s := range srcs {
+        tk.begin(s.name)
+        if err := writeZipSource(ctx, zw, s, opts.Compression, a, tk); err != nil {
+            return err
+        }
+        tk.entryDone()
+    }
+    if err := zw.Close(); err != nil {
+        return err
+    }
+    tk.done()
+    return nil
+}
+
+func writeZipSource(ctx context.Context, zw *zip.Writer, s source, policy *CompressionPolicy, a *zipAligner, tk *tracker) error {
+    fh, err := zip.FileInfoHeader(s.info)
+    if err != nil {
+        return err
+    }
+    fh.Name = s.name
+    setZipModified(fh, s.info.ModTime())
+    if s.info.Mode().IsRegular() {
+        fh.Method = zip.Deflate
+        if policy != nil {
+            bp := sampleBuffers.Get().(*[]byte)
+            buf := *bp
+            if n := policy.sampleSize(); n != len(buf) {
+                buf = make([]byte, n)
+            }
+            sample, err := readSample(s.path, buf)
+            if err == nil {
+                fh.Method = policy.Decide(s.name, sample).Method
+            }
+            sampleBuffers.Put(bp)
+            if err != nil {
+                return err
+            }
+        }
+    }
+    if a != nil {
+        var link string
+        switch {
+        case s.info.IsDir():
+            return a.writeDir(fh)
+        case s.info.Mode().IsRegular():
+            return a.writeFile(ctx, fh, s.path, tk)
+        case s.info.Mode()&os.ModeSymlink != 0:
+            if link, err = os.Readlink(s.path); err != nil {
+                return err
+            }
+        }
+        return a.writeBytes(fh, []byte(link))
+    }
+    fw, err := zw.CreateHeader(fh)
+    if err != nil {
+        return err
+    }
+    switch {
+    case s.info.Mode()&os.ModeSymlink != 0:
+        link, err := os.Readlink(s.path)
+        if err != nil {
+            return err
+        }
+        _, err = io.Copy(fw, strings.NewReader(link))
+        return err
+    case s.info.Mode().IsRegular():
+        return copyFile(ctx, fw, s.path, tk)
+    }
+    return nil
+}
+
+// readSample reads the leading bytes of the named file into b, returning
+// the part filled.
+func readSample(name string, b []byte) ([]byte, error) {
+    f, err := os.Open(name)
+    if err != nil {
+        return nil, err
+    }
+    defer f.Close()
+    n, err := io.ReadFull(f, b)
+    if err == io.EOF || err == io.ErrUnexpectedEOF {
+        err = nil
+    }
+    return b[:n], err
+}
+
+func copyFile(ctx context.Context, w io.Writer, name string, tk *tracker) error {
+    f, err := os.Open(name)
+    if err != nil {
+        return err
+    }
+    defer f.Close()
+    _, err = copyBuffer(w, &ctxReader{ctx: ctx, r: f, count: tk.addIn})
+    return err
+}
@@ -0,0 +1,128 @@
+package archive
+
+import (
+    "archive/tar"
+    "archive/zip"
+    "bytes"
+    "context"
+    "io"
+    "io/ioutil"
+    "os"
+    "path/filepath"
+    "reflect"
+    "testing"
+)
+
+var treeFiles = map[string]string{
+    "readme.txt":     "This archive contains some text files.",
+    "gopher.txt":     "Gopher names:\nGeorge\nGeoffrey\nGonzo",
+    "docs/todo.txt":  "Get animal handling licence.\nWrite more examples.",
+    "docs/empty.txt": "",
+}
+
+// tempDir creates a temporary directory removed when the test ends.
+func tempDir(t testing.TB) string {
+    dir, err := ioutil.TempDir("", "archive-test")
+    if err != nil {
+        t.Fatal(err)
+    }
+    t.Cleanup(func() { os.RemoveAll(dir) })
+    return dir
+}
+
+// writeTree populates dir with files, creating parents as needed.
+func writeTree(t testing.TB, dir string, files map[string]string) {
+    for name, body := range files {
+        p := filepath.Join(dir, filepath.FromSlash(name))
+        if err := os.MkdirAll(filepath.Dir(p), 0755); err != nil {
+            t.Fatal(err)
+        }
+        if err := ioutil.WriteFile(p, []byte(body), 0644); err != nil {
+            t.Fatal(err)
+        }
+    }
+}
+
+// checkTree verifies that dir holds exactly the regular files in files.
+func checkTree(t testing.TB, dir string, files map[string]string) {
+    got := map[string]string{}
+    err := filepath.Walk(dir, func(p string, fi os.FileInfo, err error) error {
+        if err != nil || !fi.Mode().IsRegular() {
+            return err
+        }
+        b, err := ioutil.ReadFile(p)
+        rel, _ := filepath.Rel(dir, p)
+        got[filepath.ToSlash(rel)] = string(b)
+        return err
+    })
+    if err != nil {
+        t.Fatal(err)
+    }
+    if len(got) != len(files) {
+        t.Fatalf("got %d files, want %d: %v", len(got), len(files), got)
+    }
+    for name, body := range files {
+        if got[name] != body {
+            t.Errorf("%s = %q, want %q", name, got[name], body)
+        }
+    }
+}
+
+func TestWriteTar(t *testing.T) {
+    src := tempDir(t)
+    writeTree(t, src, treeFiles)
+    var buf bytes.Buffer
+    if err := WriteTar(context.Background(), &buf, src, nil); err != nil {
+        t.Fatal(err)
+    }
+    var names []string
+    tr := tar.NewReader(&buf)
+    for {
+        hdr, err := tr.Next()
+        if err == io.EOF {
+            break
+        }
+        if err != nil {
+            t.Fatal(err)
+        }
+        names = append(names, hdr.Name)
+    }
+    want := []string{"docs/", "docs/empty.txt", "docs/todo.txt", "gopher.txt", "readme.txt"}
+    if !reflect.DeepEqual(names, want) {
+        t.Fatalf("entries = %v, want %v", names, want)
+    }
+}
+
+func TestWriteZip(t *testing.T) {
+    src := tempDir(t)
+    writeTree(t, src, treeFiles)
+    var buf bytes.Buffer
+    if err := WriteZip(context.Background(), &buf, src, nil); err != nil {
+        t.Fatal(err)
+    }
+    zr, err := zip.NewReader(bytes.NewReader(buf.Bytes()), int64(buf.Len()))
+    if err != nil {
+        t.Fatal(err)
+    }
+    if len(zr.File) != 5 {
+        t.Fatalf("got %d entries, want 5", len(zr.File))
+    }
+}
+
+func TestCreateCancelRemovesOutput(t *testing.T) {
+    src := tempDir(t)
+    writeTree(t, src, treeFiles)
+    dst := filepath.Join(tempDir(t), "out.zip")
+    ctx, cancel := context.WithCancel(context.Background())
+    opts := &CreateOptions{Progress: func(p Progress) {
+        if p.Entries == 2 {
+            cancel()
+        }
+    }}
+    if err := Create(ctx, dst, src, opts); err != context.Canceled {
+        t.Fatalf("Create = %v, want context.Canceled", err)
+    }
+    if _, err := os.Stat(dst); !os.IsNotExist(err) {
+        t.Fatalf("partial archive left behind: %v", err)
+    }
+}
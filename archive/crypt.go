@@ -0,0 +1,385 @@
+package archive
+
+import (
+    "bufio"
+    "crypto/aes"
+    "crypto/cipher"
+    "crypto/rand"
+    "encoding/binary"
+    "errors"
+    "fmt"
+    "io"
+)
+
+// Encrypted streams wrap any archive, typically a tar stream on its way
+// to untrusted storage. The data is cut into chunks of a fixed size, each
+// sealed with an AEAD under a nonce made of the chunk's number and a flag
+// marking the last chunk, so that reordered, dropped and truncated chunks
+// all fail to open. A 32 byte header precedes the chunks:
+//
+//	magic "ARCENC", version 1
+//	cipher, key derivation (0 raw key, 1 scrypt), scrypt log2 N, r, p
+//	chunk size, big endian uint32
+//	salt, 16 random bytes
+//
+// The header is authenticated as additional data of every chunk. The
+// chunk key is derived from the salt and the raw or scrypt key with
+// HKDF-SHA256, so a raw key can be reused across streams.
+
+// Cipher is the AEAD of an encrypted stream.
+type Cipher uint8
+
+const (
+    // CipherAESGCM is AES-256 in Galois/Counter Mode.
+    CipherAESGCM Cipher = iota + 1
+    // CipherChaCha20Poly1305 is ChaCha20-Poly1305 from RFC 8439, faster
+    // than AES-GCM without AES hardware.
+    CipherChaCha20Poly1305
+)
+
+func (c Cipher) String() string {
+    switch c {
+    case CipherAESGCM:
+        return "aes-256-gcm"
+    case CipherChaCha20Poly1305:
+        return "chacha20-poly1305"
+    }
+    return fmt.Sprintf("Cipher(%d)", uint8(c))
+}
+
+var (
+    // ErrDecrypt is returned when a chunk fails authentication, because
+    // the data was altered or the key or passphrase is wrong.
+    ErrDecrypt = errors.New("archive: encrypted data is corrupt or the key is wrong")
+    // ErrTruncated is returned when an encrypted stream ends before its
+    // last chunk.
+    ErrTruncated = errors.New("archive: encrypted data truncated")
+)
+
+// CryptOptions configures NewEncryptWriter and NewDecryptReader. Exactly
+// one of Key and Passphrase must be set.
+type CryptOptions struct {
+    // Key is a raw key of 32 bytes.
+    Key []byte
+    // Passphrase is stretched into a key with scrypt.
+    Passphrase string
+    // Cipher is the AEAD used to encrypt. Zero means CipherAESGCM. When
+    // decrypting, the cipher is read from the header.
+    Cipher Cipher
+    // ChunkSize is the size of the plaintext chunks written. Zero means
+    // 64 KiB.
+    ChunkSize int
+    // ScryptLogN is the base 2 logarithm of the scrypt cost used to
+    // encrypt with a passphrase. Zero means 15, which takes 32 MiB.
+    ScryptLogN int
+    // MaxScryptLogN is the largest cost accepted when decrypting, so a
+    // forged header cannot demand unbounded memory. Zero means 20. The
+    // other scrypt parameters in the header are held to r ≤ 32 and p ≤ 16.
+    MaxScryptLogN int
+}
+
+const (
+    cryptMagic        = "ARCENC\x01"
+    cryptHeaderSize   = 32
+    cryptSaltSize     = 16
+    cryptKeySize      = 32
+    maxCryptChunkSize = 16 << 20
+    maxScryptR        = 32
+    maxScryptP        = 16
+    cryptInfo         = "archive encrypted stream v1"
+)
+
+const (
+    kdfRaw = iota
+    kdfScrypt
+)
+
+// cryptHeader is the decoded header of an encrypted stream.
+type cryptHeader struct {
+    cipher    Cipher
+    kdf       uint8
+    logN      uint8
+    r, p      uint8
+    chunkSize int
+    salt      [cryptSaltSize]byte
+}
+
+func (h *cryptHeader) marshal() []byte {
+    b := make([]byte, cryptHeaderSize)
+    copy(b, cryptMagic)
+    b[7], b[8], b[9], b[10], b[11] = byte(h.cipher), h.kdf, h.logN, h.r, h.p
+    binary.BigEndian.PutUint32(b[12:], uint32(h.chunkSize))
+    copy(b[16:], h.salt[:])
+    return b
+}
+
+func (h *cryptHeader) unmarshal(b []byte) error {
+    if string(b[:len(cryptMagic)]) != cryptMagic {
+        return fmt.Errorf("%w: not an encrypted stream", ErrFormat)
+    }
+    h.cipher, h.kdf, h.logN, h.r, h.p = Cipher(b[7]), b[8], b[9], b[10], b[11]
+    h.chunkSize = int(binary.BigEndian.Uint32(b[12:]))
+    copy(h.salt[:], b[16:])
+    if h.chunkSize < 1 || h.chunkSize > maxCryptChunkSize {
+        return fmt.Errorf("%w: chunk size %d", ErrFormat, h.chunkSize)
+    }
+    return nil
+}
+
+// aead derives the chunk key of the stream and returns its cipher.
+func (h *cryptHeader) aead(opts *CryptOptions) (cipher.AEAD, error) {
+    var secret []byte
+    switch {
+    case (opts.Key != nil) == (opts.Passphrase != ""):
+        return nil, errors.New("archive: set exactly one of Key and Passphrase")
+    case opts.Key != nil && h.kdf != kdfRaw, opts.Key == nil && h.kdf != kdfScrypt:
+        return nil, fmt.Errorf("%w: stream and options disagree on the key type", ErrDecrypt)
+    case opts.Key != nil:
+        if len(opts.Key) != cryptKeySize {
+            return nil, fmt.Errorf("archive: key is %d bytes, want %d", len(opts.Key), cryptKeySize)
+        }
+        secret = opts.Key
+    default:
+        max := opts.MaxScryptLogN
+        if max == 0 {
+            max = 20
+        }
+        if int(h.logN) > max {
+            return nil, fmt.Errorf("archive: scrypt cost 2^%d above the limit 2^%d", h.logN, max)
+        }
+        if h.r > maxScryptR || h.p > maxScryptP {
+            return nil, fmt.Errorf("archive: scrypt parameters r=%d p=%d above the limits r=%d p=%d", h.r, h.p, maxScryptR, maxScryptP)
+        }
+        var err error
+        secret, err = scrypt([]byte(opts.Passphrase), h.salt[:], 1<<h.logN, int(h.r), int(h.p), cryptKeySize)
+        if err != nil {
+            return nil, err
+        }
+    }
+    key := hkdfSHA256(secret, h.salt[:], []byte(cryptInfo), cryptKeySize)
+    switch h.cipher {
+    case CipherAESGCM:
+        block, err := aes.NewCipher(key)
+        if err != nil {
+            return nil, err
+        }
+        return cipher.NewGCM(block)
+    case CipherChaCha20Poly1305:
+        return newChaCha20Poly1305(key)
+    }
+    return nil, fmt.Errorf("%w: unknown cipher %v", ErrFormat, h.cipher)
+}
+
+// chunkNonce returns the nonce of chunk i: an 11 byte big endian counter
+// and a byte set to 1 on the last chunk.
+func chunkNonce(nonce []byte, i uint64, last bool) {
+    for j := range nonce {
+        nonce[j] = 0
+    }
+    binary.BigEndian.PutUint64(nonce[3:11], i)
+    if last {
+        nonce[11] = 1
+    }
+}
+
+// EncryptWriter encrypts the data written to it into an encrypted stream.
+type EncryptWriter struct {
+    w      io.Writer
+    aead   cipher.AEAD
+    header []byte
+    buf    []byte // plaintext of the chunk being filled
+    out    []byte
+    nonce  []byte
+    chunk  uint64
+    err    error
+}
+
+// NewEncryptWriter writes the header of an encrypted stream to w and
+// returns a writer encrypting into it. Close must be called to write the
+// last chunk.
+func NewEncryptWriter(w io.Writer, opts *CryptOptions) (*EncryptWriter, error) {
+    if opts == nil {
+        opts = &CryptOptions{}
+    }
+    h := &cryptHeader{cipher: opts.Cipher, chunkSize: opts.ChunkSize, kdf: kdfRaw}
+    if h.cipher == 0 {
+        h.cipher = CipherAESGCM
+    }
+    if h.chunkSize == 0 {
+        h.chunkSize = 64 << 10
+    }
+    if h.chunkSize < 1 || h.chunkSize > maxCryptChunkSize {
+        return nil, fmt.Errorf("archive: chunk size %d out of range", h.chunkSize)
+    }
+    if opts.Passphrase != "" {
+        h.kdf, h.logN, h.r, h.p = kdfScrypt, 15, 8, 1
+        if opts.ScryptLogN != 0 {
+            if opts.ScryptLogN < 1 || opts.ScryptLogN > 30 {
+                return nil, fmt.Errorf("archive: scrypt cost 2^%d out of range", opts.ScryptLogN)
+            }
+            h.logN = uint8(opts.ScryptLogN)
+        }
+    }
+    if _, err := io.ReadFull(rand.Reader, h.salt[:]); err != nil {
+        return nil, err
+    }
+    eopts := *opts
+    eopts.MaxScryptLogN = int(h.logN) // the decryption limit is not ours to apply
+    aead, err := h.aead(&eopts)
+    if err != nil {
+        return nil, err
+    }
+    header := h.marshal()
+    if _, err := w.Write(header); err != nil {
+        return nil, err
+    }
+    return &EncryptWriter{
+        w:      w,
+        aead:   aead,
+        header: header,
+        buf:    make([]byte, 0, h.chunkSize),
+        out:    make([]byte, 0, h.chunkSize+aead.Overhead()),
+        nonce:  make([]byte, aead.NonceSize()),
+    }, nil
+}
+
+// Write encrypts p. A full chunk is only sealed once more data arrives,
+// since the last chunk is sealed differently.
+func (w *EncryptWriter) Write(p []byte) (int, error) {
+    if w.err != nil {
+        return 0, w.err
+    }
+    n := 0
+    for len(p) > 0 {
+        if len(w.buf) == cap(w.buf) {
+            if err := w.seal(false); err != nil {
+                return n, err
+            }
+        }
+        k := copy(w.buf[len(w.buf):cap(w.buf)], p)
+        w.buf = w.buf[:len(w.buf)+k]
+        n += k
+        p = p[k:]
+    }
+    return n, nil
+}
+
+// Close seals the last chunk. It does not close the underlying writer.
+func (w *EncryptWriter) Close() error {
+    if w.err != nil {
+        return w.err
+    }
+    err := w.seal(true)
+    if err == nil {
+        w.err = errors.New("archive: write to closed EncryptWriter")
+    }
+    return err
+}
+
+func (w *EncryptWriter) seal(last bool) error {
+    chunkNonce(w.nonce, w.chunk, last)
+    w.out = w.aead.Seal(w.out[:0], w.nonce, w.buf, w.header)
+    w.chunk++
+    w.buf = w.buf[:0]
+    if _, err := w.w.Write(w.out); err != nil {
+        w.err = err
+        return err
+    }
+    return nil
+}
+
+// DecryptReader decrypts an encrypted stream. No plaintext of a chunk is
+// returned before the whole chunk has been authenticated.
+type DecryptReader struct {
+    r      *bufio.Reader
+    aead   cipher.AEAD
+    header []byte
+    in     []byte // sealed chunk
+    out    []byte // plaintext of the current chunk
+    plain  []byte // its unread part
+    nonce  []byte
+    chunk  uint64
+    done   bool
+    err    error
+}
+
+// NewDecryptReader reads the header of the encrypted stream in r and
+// returns a reader of its plaintext.
+func NewDecryptReader(r io.Reader, opts *CryptOptions) (*DecryptReader, error) {
+    if opts == nil {
+        opts = &CryptOptions{}
+    }
+    header := make([]byte, cryptHeaderSize)
+    if _, err := io.ReadFull(r, header); err != nil {
+        if err == io.EOF || err == io.ErrUnexpectedEOF {
+            err = ErrTruncated
+        }
+        return nil, err
+    }
+    h := &cryptHeader{}
+    if err := h.unmarshal(header); err != nil {
+        return nil, err
+    }
+    aead, err := h.aead(opts)
+    if err != nil {
+        return nil, err
+    }
+    return &DecryptReader{
+        r:      bufio.NewReader(r),
+        aead:   aead,
+        header: header,
+        in:     make([]byte, h.chunkSize+aead.Overhead()),
+        out:    make([]byte, 0, h.chunkSize),
+        nonce:  make([]byte, aead.NonceSize()),
+    }, nil
+}
+
+func (d *DecryptReader) Read(p []byte) (int, error) {
+    for len(d.plain) == 0 {
+        if d.err != nil {
+            return 0, d.err
+        }
+        if d.done {
+            return 0, io.EOF
+        }
+        d.err = d.next()
+    }
+    n := copy(p, d.plain)
+    d.plain = d.plain[n:]
+    return n, nil
+}
+
+// next reads and opens the next chunk. A chunk is the last one when
+// nothing follows it.
+func (d *DecryptReader) next() error {
+    n, err := io.ReadFull(d.r, d.in)
+    switch err {
+    case nil:
+        _, err = d.r.Peek(1)
+        if err != nil && err != io.EOF {
+            return err
+        }
+        d.done = err == io.EOF
+    case io.ErrUnexpectedEOF:
+        d.done = true
+    case io.EOF:
+        return ErrTruncated
+    default:
+        return err
+    }
+    chunkNonce(d.nonce, d.chunk, d.done)
+    plain, err := d.aead.Open(d.out[:0], d.nonce, d.in[:n], d.header)
+    if err != nil && d.done {
+        // A chunk that opens as an inner one means the rest is missing.
+        chunkNonce(d.nonce, d.chunk, false)
+        if _, err := d.aead.Open(d.out[:0], d.nonce, d.in[:n], d.header); err == nil {
+            return ErrTruncated
+        }
+    }
+    if err != nil {
+        return ErrDecrypt
+    }
+    d.plain = plain
+    d.chunk++
+    return nil
+}
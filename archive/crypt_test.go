@@ -0,0 +1,173 @@
+package archive
+
+import (
+    "bytes"
+    "context"
+    "encoding/hex"
+    "errors"
+    "io/ioutil"
+    "strings"
+    "testing"
+)
+
+func TestChaCha20Poly1305Vector(t *testing.T) {
+    // RFC 8439, section 2.8.2.
+    key, _ := hex.DecodeString("808182838485868788898a8b8c8d8e8f909192939495969798999a9b9c9d9e9f")
+    nonce, _ := hex.DecodeString("070000004041424344454647")
+    ad, _ := hex.DecodeString("50515253c0c1c2c3c4c5c6c7")
+    plaintext := []byte("Ladies and Gentlemen of the class of '99: If I could offer you only one tip for the future, sunscreen would be it.")
+    want := "d31a8d34648e60db7b86afbc53ef7ec2a4aded51296e08fea9e2b5a736ee62d63dbea45e8ca9671282fafb69da92728b1a71de0a9e060b2905d6a5b67ecd3b3692ddbd7f2d778b8c9803aee328091b58fab324e4fad675945585808b4831d7bc3ff4def08e4b7a9de576d26586cec64b6116" +
+        "1ae10b594f09e26a7e902ecbd0600691"
+
+    c, err := newChaCha20Poly1305(key)
+    if err != nil {
+        t.Fatal(err)
+    }
+    sealed := c.Seal(nil, nonce, plaintext, ad)
+    if got := hex.EncodeToString(sealed); got != want {
+        t.Fatalf("Seal = %s\nwant %s", got, want)
+    }
+    opened, err := c.Open(nil, nonce, sealed, ad)
+    if err != nil || !bytes.Equal(opened, plaintext) {
+        t.Fatalf("Open = %q, %v", opened, err)
+    }
+    sealed[len(sealed)-1] ^= 1
+    if _, err := c.Open(nil, nonce, sealed, ad); err == nil {
+        t.Error("Open accepted a forged tag")
+    }
+
+    // RFC 8439, section 2.5.2.
+    polyKey, _ := hex.DecodeString("85d6be7857556d337f4452fe42d506a80103808afb0db2fd4abff6af4149f51b")
+    var p poly1305
+    p.init(polyKey)
+    p.write([]byte("Cryptographic Forum Research Group"))
+    if tag := p.sum(); hex.EncodeToString(tag[:]) != "a8061dc1305136c6c22b8baf0c0127a9" {
+        t.Errorf("Poly1305 = %x", tag)
+    }
+}
+
+func TestScryptVector(t *testing.T) {
+    // RFC 7914, section 12.
+    for _, tt := range []struct {
+        password, salt string
+        n, r, p        int
+        want           string
+    }{
+        {"", "", 16, 1, 1, "77d6576238657b203b19ca42c18a0497f16b4844e3074ae8dfdffa3fede21442fcd0069ded0948f8326a753a0fc81f17e8d3e0fb2e0d3628cf35e20c38d18906"},
+        {"password", "NaCl", 1024, 8, 16, "fdbabe1c9d3472007856e7190d01e9fe7c6ad7cbc8237830e77376634b3731622eaf30d92e22a3886ff109279d9830dac727afb94a83ee6d8360cbdfa2cc0640"},
+    } {
+        got, err := scrypt([]byte(tt.password), []byte(tt.salt), tt.n, tt.r, tt.p, 64)
+        if err != nil || hex.EncodeToString(got) != tt.want {
+            t.Errorf("scrypt(%q, %q) = %x, %v", tt.password, tt.salt, got, err)
+        }
+    }
+}
+
+// encryptedTar returns the tar of files encrypted with opts.
+func encryptedTar(t *testing.T, files map[string]string, opts *CryptOptions) []byte {
+    src := tempDir(t)
+    writeTree(t, src, files)
+    var buf bytes.Buffer
+    ew, err := NewEncryptWriter(&buf, opts)
+    if err != nil {
+        t.Fatal(err)
+    }
+    if err := WriteTar(context.Background(), ew, src, nil); err != nil {
+        t.Fatal(err)
+    }
+    if err := ew.Close(); err != nil {
+        t.Fatal(err)
+    }
+    return buf.Bytes()
+}
+
+func TestEncryptedTar(t *testing.T) {
+    files := map[string]string{
+        "backup/db.sql": string(bytes.Repeat([]byte("INSERT INTO t VALUES (1);\n"), 500)),
+        "backup/notes":  "secret",
+    }
+    key := bytes.Repeat([]byte{7}, 32)
+    for _, opts := range []*CryptOptions{
+        {Key: key, ChunkSize: 1000},
+        {Key: key, Cipher: CipherChaCha20Poly1305, ChunkSize: 512},
+        {Passphrase: "correct horse", ScryptLogN: 10},
+    } {
+        blob := encryptedTar(t, files, opts)
+        if bytes.Contains(blob, []byte("INSERT")) {
+            t.Fatalf("%v: plaintext visible", opts.Cipher)
+        }
+        dr, err := NewDecryptReader(bytes.NewReader(blob), opts)
+        if err != nil {
+            t.Fatal(err)
+        }
+        dst := tempDir(t)
+        if err := ExtractTar(context.Background(), dr, dst, nil); err != nil {
+            t.Fatalf("%v: %v", opts.Cipher, err)
+        }
+        checkTree(t, dst, files)
+    }
+}
+
+func TestEncryptedTamper(t *testing.T) {
+    key := bytes.Repeat([]byte{7}, 32)
+    opts := &CryptOptions{Key: key, ChunkSize: 1000}
+    blob := encryptedTar(t, map[string]string{"a": string(bytes.Repeat([]byte("x"), 5000))}, opts)
+    sealed := 1000 + 16
+
+    decrypt := func(b []byte, opts *CryptOptions) error {
+        dr, err := NewDecryptReader(bytes.NewReader(b), opts)
+        if err != nil {
+            return err
+        }
+        _, err = ioutil.ReadAll(dr)
+        return err
+    }
+    flipped := append([]byte(nil), blob...)
+    flipped[cryptHeaderSize+sealed+10] ^= 1
+    swapped := append([]byte(nil), blob[:cryptHeaderSize]...)
+    swapped = append(swapped, blob[cryptHeaderSize+sealed:cryptHeaderSize+2*sealed]...)
+    swapped = append(swapped, blob[cryptHeaderSize:cryptHeaderSize+sealed]...)
+    swapped = append(swapped, blob[cryptHeaderSize+2*sealed:]...)
+    header := append([]byte(nil), blob...)
+    header[cryptHeaderSize-1] ^= 1 // the salt, and so the key
+
+    for _, tt := range []struct {
+        name string
+        b    []byte
+        opts *CryptOptions
+        want error
+    }{
+        {"intact", blob, opts, nil},
+        {"chunk boundary", blob[:cryptHeaderSize+2*sealed], opts, ErrTruncated},
+        {"mid chunk", blob[:cryptHeaderSize+2*sealed+100], opts, ErrDecrypt},
+        {"header only", blob[:cryptHeaderSize], opts, ErrTruncated},
+        {"bit flip", flipped, opts, ErrDecrypt},
+        {"reordered", swapped, opts, ErrDecrypt},
+        {"header", header, opts, ErrDecrypt},
+        {"wrong key", blob, &CryptOptions{Key: bytes.Repeat([]byte{8}, 32)}, ErrDecrypt},
+        {"passphrase for a raw key", blob, &CryptOptions{Passphrase: "x"}, ErrDecrypt},
+    } {
+        if err := decrypt(tt.b, tt.opts); !errors.Is(err, tt.want) && err != tt.want {
+            t.Errorf("%s: err = %v, want %v", tt.name, err, tt.want)
+        }
+    }
+
+    // A forged header cannot make scrypt take gigabytes at an accepted
+    // cost: 128·r·N·p is bounded through r and p too.
+    popts := &CryptOptions{Passphrase: "x", ScryptLogN: 10}
+    pblob := encryptedTar(t, map[string]string{"a": "x"}, popts)
+    for _, off := range []int{10, 11} {
+        forged := append([]byte(nil), pblob...)
+        forged[off] = 255
+        if err := decrypt(forged, &CryptOptions{Passphrase: "x"}); err == nil || !strings.Contains(err.Error(), "above the limits") {
+            t.Errorf("header byte %d forged: err = %v", off, err)
+        }
+    }
+
+    // Nothing of a chunk is released before it is authenticated.
+    dr, _ := NewDecryptReader(bytes.NewReader(flipped), opts)
+    got, err := ioutil.ReadAll(dr)
+    if err != ErrDecrypt || len(got) != 1000 {
+        t.Errorf("read %d bytes before %v", len(got), err)
+    }
+}
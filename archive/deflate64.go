@@ -0,0 +1,674 @@
+package archive
+
+import (
+    "bufio"
+    "errors"
+    "io"
+)
+
+// Deflate64 is Deflate with a 64 KiB window: length code 285 takes 16
+// extra bits and distance codes 30 and 31 are used.
+
+var errDeflate64 = errors.New("archive: corrupt deflate64 data")
+
+const (
+    deflate64Window = 1 << 16
+    deflate64MaxLen = 65538
+)
+
+var (
+    deflate64LenBase = [29]int{
+        3, 4, 5, 6, 7, 8, 9, 10, 11, 13, 15, 17, 19, 23, 27, 31,
+        35, 43, 51, 59, 67, 83, 99, 115, 131, 163, 195, 227, 3}
+    deflate64LenExtra = [29]uint{
+        0, 0, 0, 0, 0, 0, 0, 0, 1, 1, 1, 1, 2, 2, 2, 2,
+        3, 3, 3, 3, 4, 4, 4, 4, 5, 5, 5, 5, 16}
+    deflate64DistBase = [32]int{
+        1, 2, 3, 4, 5, 7, 9, 13, 17, 25, 33, 49, 65, 97, 129, 193,
+        257, 385, 513, 769, 1025, 1537, 2049, 3073, 4097, 6145, 8193, 12289, 16385, 24577, 32769, 49153}
+    deflate64DistExtra = [32]uint{
+        0, 0, 0, 0, 1, 1, 2, 2, 3, 3, 4, 4, 5, 5, 6, 6,
+        7, 7, 8, 8, 9, 9, 10, 10, 11, 11, 12, 12, 13, 13, 14, 14}
+    // Order in which code length code lengths are stored.
+    deflateCLOrder = [19]int{16, 17, 18, 0, 8, 7, 9, 6, 10, 5, 11, 4, 12, 3, 13, 2, 14, 1, 15}
+)
+
+// huffDecoder decodes canonical Huffman codes one bit at a time.
+type huffDecoder struct {
+    count  [16]int // number of codes of each length
+    symbol []int   // symbols ordered by code
+}
+
+func newHuffDecoder(lengths []uint8) (*huffDecoder, error) {
+    h := &huffDecoder{symbol: make([]int, 0, len(lengths))}
+    for _, l := range lengths {
+        h.count[l]++
+    }
+    h.count[0] = 0
+    left := 1
+    for l := 1; l < 16; l++ {
+        left = left<<1 - h.count[l]
+        if left < 0 {
+            return nil, errDeflate64
+        }
+    }
+    for l := 1; l < 16; l++ {
+        for s, sl := range lengths {
+            if int(sl) == l {
+                h.symbol = append(h.symbol, s)
+            }
+        }
+    }
+    return h, nil
+}
+
+// inflate64 decompresses a Deflate64 stream.
+type inflate64 struct {
+    br    io.ByteReader
+    bits  uint32
+    nbits uint
+    hist  []byte // decoded output; the last 64 KiB are the window
+    rpos  int    // next byte of hist to return
+    final bool   // the current block is the last one
+    inBlk bool
+    store int // bytes left in a stored block
+    lit   *huffDecoder
+    dist  *huffDecoder
+    err   error
+}
+
+// newDeflate64Reader returns a reader decompressing the Deflate64 stream
+// in r. When r is an io.ByteReader no input past the end of the stream is
+// consumed.
+func newDeflate64Reader(r io.Reader) io.ReadCloser {
+    br, ok := r.(io.ByteReader)
+    if !ok {
+        br = bufio.NewReader(r)
+    }
+    return &inflate64{br: br}
+}
+
+func (f *inflate64) Read(p []byte) (int, error) {
+    for f.rpos == len(f.hist) {
+        if f.err != nil {
+            return 0, f.err
+        }
+        if len(f.hist) > 4*deflate64Window {
+            n := copy(f.hist, f.hist[len(f.hist)-deflate64Window:])
+            f.hist = f.hist[:n]
+            f.rpos = n
+        }
+        f.err = f.step()
+    }
+    n := copy(p, f.hist[f.rpos:])
+    f.rpos += n
+    return n, nil
+}
+
+func (f *inflate64) Close() error { return nil }
+
+func (f *inflate64) need(n uint) error {
+    for f.nbits < n {
+        b, err := f.br.ReadByte()
+        if err != nil {
+            if err == io.EOF {
+                err = io.ErrUnexpectedEOF
+            }
+            return err
+        }
+        f.bits |= uint32(b) << f.nbits
+        f.nbits += 8
+    }
+    return nil
+}
+
+func (f *inflate64) readBits(n uint) (int, error) {
+    if n == 0 {
+        return 0, nil
+    }
+    if err := f.need(n); err != nil {
+        return 0, err
+    }
+    v := f.bits & (1<<n - 1)
+    f.bits >>= n
+    f.nbits -= n
+    return int(v), nil
+}
+
+func (f *inflate64) decode(h *huffDecoder) (int, error) {
+    code, first, index := 0, 0, 0
+    for l := 1; l < 16; l++ {
+        b, err := f.readBits(1)
+        if err != nil {
+            return 0, err
+        }
+        code |= b
+        count := h.count[l]
+        if code-count < first {
+            return h.symbol[index+code-first], nil
+        }
+        index += count
+        first = (first + count) << 1
+        code <<= 1
+    }
+    return 0, errDeflate64
+}
+
+// step decodes up to about 32 KiB of output. It returns io.EOF after the
+// last block.
+func (f *inflate64) step() error {
+    if !f.inBlk {
+        if f.final {
+            return io.EOF
+        }
+        if err := f.header(); err != nil {
+            return err
+        }
+    }
+    if f.lit == nil {
+        // Stored block.
+        for f.store > 0 && len(f.hist)-f.rpos < 32<<10 {
+            b, err := f.br.ReadByte()
+            if err != nil {
+                return unexpected(err)
+            }
+            f.hist = append(f.hist, b)
+            f.store--
+        }
+        f.inBlk = f.store > 0
+        return nil
+    }
+    for len(f.hist)-f.rpos < 32<<10 {
+        sym, err := f.decode(f.lit)
+        if err != nil {
+            return err
+        }
+        switch {
+        case sym < 256:
+            f.hist = append(f.hist, byte(sym))
+            continue
+        case sym == 256:
+            f.inBlk = false
+            return nil
+        case sym > 285:
+            return errDeflate64
+        }
+        extra, err := f.readBits(deflate64LenExtra[sym-257])
+        if err != nil {
+            return err
+        }
+        length := deflate64LenBase[sym-257] + extra
+        dsym, err := f.decode(f.dist)
+        if err != nil {
+            return err
+        }
+        if extra, err = f.readBits(deflate64DistExtra[dsym]); err != nil {
+            return err
+        }
+        dist := deflate64DistBase[dsym] + extra
+        if dist > len(f.hist) {
+            return errDeflate64
+        }
+        for i := len(f.hist) - dist; length > 0; length-- {
+            f.hist = append(f.hist, f.hist[i])
+            i++
+        }
+    }
+    return nil
+}
+
+// header reads a block header.
+func (f *inflate64) header() error {
+    hdr, err := f.readBits(3)
+    if err != nil {
+        return err
+    }
+    f.final = hdr&1 != 0
+    f.inBlk = true
+    switch hdr >> 1 {
+    case 0:
+        f.bits, f.nbits = 0, 0 // align to a byte
+        var b [4]byte
+        for i := range b {
+            if b[i], err = f.br.ReadByte(); err != nil {
+                return unexpected(err)
+            }
+        }
+        n, nn := int(b[0])|int(b[1])<<8, int(b[2])|int(b[3])<<8
+        if n != ^nn&0xffff {
+            return errDeflate64
+        }
+        f.lit, f.dist, f.store = nil, nil, n
+        f.inBlk = n > 0
+        return nil
+    case 1:
+        var l [288]uint8
+        for i := range l {
+            switch {
+            case i < 144:
+                l[i] = 8
+            case i < 256:
+                l[i] = 9
+            case i < 280:
+                l[i] = 7
+            default:
+                l[i] = 8
+            }
+        }
+        d := make([]uint8, 32)
+        for i := range d {
+            d[i] = 5
+        }
+        return f.tables(l[:], d)
+    case 2:
+        return f.dynamic()
+    }
+    return errDeflate64
+}
+
+func (f *inflate64) tables(lit, dist []uint8) (err error) {
+    if f.lit, err = newHuffDecoder(lit); err != nil {
+        return err
+    }
+    f.dist, err = newHuffDecoder(dist)
+    return err
+}
+
+func (f *inflate64) dynamic() error {
+    hlit, err := f.readBits(5)
+    if err != nil {
+        return err
+    }
+    hdist, err := f.readBits(5)
+    if err != nil {
+        return err
+    }
+    hclen, err := f.readBits(4)
+    if err != nil {
+        return err
+    }
+    nlit, ndist := hlit+257, hdist+1
+    var cl [19]uint8
+    for i := 0; i < hclen+4; i++ {
+        v, err := f.readBits(3)
+        if err != nil {
+            return err
+        }
+        cl[deflateCLOrder[i]] = uint8(v)
+    }
+    clh, err := newHuffDecoder(cl[:])
+    if err != nil {
+        return err
+    }
+    lengths := make([]uint8, 0, nlit+ndist)
+    for len(lengths) < nlit+ndist {
+        sym, err := f.decode(clh)
+        if err != nil {
+            return err
+        }
+        if sym < 16 {
+            lengths = append(lengths, uint8(sym))
+            continue
+        }
+        var rep, val int
+        switch sym {
+        case 16:
+            if len(lengths) == 0 {
+                return errDeflate64
+            }
+            val = int(lengths[len(lengths)-1])
+            rep, err = f.readBits(2)
+            rep += 3
+        case 17:
+            rep, err = f.readBits(3)
+            rep += 3
+        default:
+            rep, err = f.readBits(7)
+            rep += 11
+        }
+        if err != nil {
+            return err
+        }
+        if len(lengths)+rep > nlit+ndist {
+            return errDeflate64
+        }
+        for ; rep > 0; rep-- {
+            lengths = append(lengths, uint8(val))
+        }
+    }
+    if lengths[256] == 0 {
+        return errDeflate64
+    }
+    return f.tables(lengths[:nlit], lengths[nlit:])
+}
+
+// deflate64Writer compresses to the Deflate64 format.
+type deflate64Writer struct {
+    m      *matcher
+    bw     lsbWriter
+    pos    int // next position of m.buf to encode
+    tokens []uint64
+    start  int // position of m.buf where the pending block starts
+}
+
+// Tokens are literals below 256, or a length (high bits) and distance
+// (low 17 bits) pair.
+const deflate64DistBits = 17
+
+func newDeflate64Writer(w io.Writer) (io.WriteCloser, error) {
+    return &deflate64Writer{
+        m:  newMatcher(deflate64Window, deflate64MaxLen, 64),
+        bw: lsbWriter{w: w},
+    }, nil
+}
+
+func (d *deflate64Writer) Write(p []byte) (int, error) {
+    if d.bw.err != nil {
+        return 0, d.bw.err
+    }
+    n := len(p)
+    for len(p) > 0 {
+        chunk := p
+        if len(chunk) > 1<<16 {
+            chunk = chunk[:1<<16]
+        }
+        p = p[len(chunk):]
+        d.m.write(chunk)
+        d.parse(deflate64MaxLen)
+    }
+    return n, d.bw.err
+}
+
+// parse encodes the buffered data except for the last keep bytes.
+func (d *deflate64Writer) parse(keep int) {
+    m := d.m
+    for d.pos < len(m.buf)-keep {
+        m.insert(d.pos)
+        length, dist := m.find(d.pos, len(m.buf))
+        if length == 0 || m.deferMatch(d.pos, len(m.buf), length) {
+            d.tokens = append(d.tokens, uint64(m.buf[d.pos]))
+            d.pos++
+        } else {
+            d.tokens = append(d.tokens, uint64(length)<<deflate64DistBits|uint64(dist))
+            d.pos += length
+        }
+        if len(d.tokens) >= 1<<15 {
+            d.block(false)
+        }
+    }
+}
+
+// block writes the pending tokens as one block.
+func (d *deflate64Writer) block(final bool) {
+    var litFreq [286]int
+    var distFreq [32]int
+    for _, t := range d.tokens {
+        if t < 256 {
+            litFreq[t]++
+            continue
+        }
+        litFreq[257+lengthCode(int(t>>deflate64DistBits))]++
+        distFreq[distCode(int(t&(1<<deflate64DistBits-1)))]++
+    }
+    litFreq[256] = 1
+    lit := huffmanLengths(litFreq[:], 15)
+    dist := huffmanLengths(distFreq[:], 15)
+    if huffmanUnused(dist) {
+        // Decoders want at least one distance code.
+        dist[0] = 1
+    }
+    bits := d.dynamicCost(lit, dist, litFreq[:], distFreq[:])
+    raw := d.pos - d.start
+    if raw < 1<<16 && int64(raw)*8+40 < bits {
+        d.stored(final, d.m.buf[d.start:d.pos])
+    } else {
+        d.dynamic(final, lit, dist)
+    }
+    d.tokens = d.tokens[:0]
+    d.start = d.pos
+    if n := d.m.slide(d.pos); n > 0 {
+        d.pos -= n
+        d.start -= n
+    }
+}
+
+func (d *deflate64Writer) dynamicCost(lit, dist []uint8, litFreq, distFreq []int) int64 {
+    bits := int64(17 + 19*3 + 19*7) // a pessimistic header estimate
+    for s, f := range litFreq {
+        bits += int64(f) * int64(lit[s])
+        if s >= 257 {
+            bits += int64(f) * int64(deflate64LenExtra[s-257])
+        }
+    }
+    for s, f := range distFreq {
+        bits += int64(f) * int64(int(dist[s])+int(deflate64DistExtra[s]))
+    }
+    return bits
+}
+
+func (d *deflate64Writer) stored(final bool, data []byte) {
+    bw := &d.bw
+    bw.writeBits(boolBit(final), 3)
+    bw.align()
+    n := len(data)
+    bw.writeBits(uint32(n), 16)
+    bw.writeBits(uint32(^n&0xffff), 16)
+    bw.writeBytes(data)
+}
+
+func (d *deflate64Writer) dynamic(final bool, lit, dist []uint8) {
+    bw := &d.bw
+    nlit, ndist := len(lit), len(dist)
+    for nlit > 257 && lit[nlit-1] == 0 {
+        nlit--
+    }
+    for ndist > 1 && dist[ndist-1] == 0 {
+        ndist--
+    }
+    // Run length encode the code lengths with symbols 16, 17 and 18.
+    all := append(append([]uint8{}, lit[:nlit]...), dist[:ndist]...)
+    var syms []uint16 // symbol | extra<<8
+    var clFreq [19]int
+    for i := 0; i < len(all); {
+        l := all[i]
+        run := 1
+        for i+run < len(all) && all[i+run] == l {
+            run++
+        }
+        i += run
+        if l == 0 {
+            for run >= 11 {
+                n := run
+                if n > 138 {
+                    n = 138
+                }
+                syms = append(syms, 18|uint16(n-11)<<8)
+                clFreq[18]++
+                run -= n
+            }
+            if run >= 3 {
+                syms = append(syms, 17|uint16(run-3)<<8)
+                clFreq[17]++
+                run = 0
+            }
+        } else if run >= 4 {
+            syms = append(syms, uint16(l))
+            clFreq[l]++
+            run--
+            for run >= 3 {
+                n := run
+                if n > 6 {
+                    n = 6
+                }
+                syms = append(syms, 16|uint16(n-3)<<8)
+                clFreq[16]++
+                run -= n
+            }
+        }
+        for ; run > 0; run-- {
+            syms = append(syms, uint16(l))
+            clFreq[l]++
+        }
+    }
+    cl := huffmanLengths(clFreq[:], 7)
+    used := 0
+    for _, l := range cl {
+        if l > 0 {
+            used++
+        }
+    }
+    if used == 1 {
+        // A code length code with a single symbol is incomplete; give
+        // it a partner so every decoder accepts it.
+        for s := range cl {
+            if cl[s] == 0 {
+                cl[s] = 1
+                break
+            }
+        }
+    }
+    clCodes := reversedCodes(cl)
+    ncl := 19
+    for ncl > 4 && cl[deflateCLOrder[ncl-1]] == 0 {
+        ncl--
+    }
+    bw.writeBits(boolBit(final)|2<<1, 3)
+    bw.writeBits(uint32(nlit-257), 5)
+    bw.writeBits(uint32(ndist-1), 5)
+    bw.writeBits(uint32(ncl-4), 4)
+    for i := 0; i < ncl; i++ {
+        bw.writeBits(uint32(cl[deflateCLOrder[i]]), 3)
+    }
+    for _, s := range syms {
+        sym := s & 0xff
+        bw.writeBits(clCodes[sym], uint(cl[sym]))
+        switch sym {
+        case 16:
+            bw.writeBits(uint32(s>>8), 2)
+        case 17:
+            bw.writeBits(uint32(s>>8), 3)
+        case 18:
+            bw.writeBits(uint32(s>>8), 7)
+        }
+    }
+    litCodes, distCodes := reversedCodes(lit), reversedCodes(dist)
+    for _, t := range d.tokens {
+        if t < 256 {
+            bw.writeBits(litCodes[t], uint(lit[t]))
+            continue
+        }
+        length, dst := int(t>>deflate64DistBits), int(t&(1<<deflate64DistBits-1))
+        lc := lengthCode(length)
+        bw.writeBits(litCodes[257+lc], uint(lit[257+lc]))
+        bw.writeBits(uint32(length-deflate64LenBase[lc]), deflate64LenExtra[lc])
+        dc := distCode(dst)
+        bw.writeBits(distCodes[dc], uint(dist[dc]))
+        bw.writeBits(uint32(dst-deflate64DistBase[dc]), deflate64DistExtra[dc])
+    }
+    bw.writeBits(litCodes[256], uint(lit[256]))
+}
+
+func (d *deflate64Writer) Close() error {
+    d.parse(0)
+    d.block(true)
+    d.bw.flush()
+    return d.bw.err
+}
+
+// lengthCode returns the index into deflate64LenBase for a match length.
+func lengthCode(length int) int {
+    if length > 258 {
+        return 28
+    }
+    c := 0
+    for c < 27 && deflate64LenBase[c+1] <= length {
+        c++
+    }
+    return c
+}
+
+// distCode returns the distance code for a match distance.
+func distCode(dist int) int {
+    c := 0
+    for c < 31 && deflate64DistBase[c+1] <= dist {
+        c++
+    }
+    return c
+}
+
+// reversedCodes returns canonical codes bit reversed for an LSB first
+// bit stream.
+func reversedCodes(lengths []uint8) []uint32 {
+    codes := canonicalCodes(lengths)
+    for s, c := range codes {
+        var r uint32
+        for i := uint8(0); i < lengths[s]; i++ {
+            r = r<<1 | c&1
+            c >>= 1
+        }
+        codes[s] = r
+    }
+    return codes
+}
+
+// huffmanUnused reports whether no symbol has a code.
+func huffmanUnused(lengths []uint8) bool {
+    for _, l := range lengths {
+        if l > 0 {
+            return false
+        }
+    }
+    return true
+}
+
+func boolBit(b bool) uint32 {
+    if b {
+        return 1
+    }
+    return 0
+}
+
+// lsbWriter writes a bit stream least significant bit first.
+type lsbWriter struct {
+    w     io.Writer
+    bits  uint64
+    nbits uint
+    buf   []byte
+    err   error
+}
+
+func (b *lsbWriter) writeBits(v uint32, n uint) {
+    b.bits |= uint64(v) << b.nbits
+    b.nbits += n
+    for b.nbits >= 8 {
+        b.buf = append(b.buf, byte(b.bits))
+        b.bits >>= 8
+        b.nbits -= 8
+    }
+    if len(b.buf) >= 32<<10 {
+        b.flushBuf()
+    }
+}
+
+func (b *lsbWriter) align() {
+    if b.nbits > 0 {
+        b.writeBits(0, 8-b.nbits)
+    }
+}
+
+func (b *lsbWriter) writeBytes(p []byte) {
+    b.buf = append(b.buf, p...)
+    b.flushBuf()
+}
+
+func (b *lsbWriter) flushBuf() {
+    if b.err == nil {
+        _, b.err = b.w.Write(b.buf)
+    }
+    b.buf = b.buf[:0]
+}
+
+func (b *lsbWriter) flush() {
+    b.align()
+    b.flushBuf()
+}
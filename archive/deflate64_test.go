@@ -0,0 +1,94 @@
+package archive
+
+import (
+    "bytes"
+    "io"
+    "io/ioutil"
+    "math/rand"
+    "strings"
+    "testing"
+)
+
+// compressInputs returns inputs exercising literals, short and long
+// matches, incompressible data and empty content.
+func compressInputs() map[string][]byte {
+    rnd := rand.New(rand.NewSource(1))
+    random := make([]byte, 200<<10)
+    rnd.Read(random)
+    words := []string{"gopher", "archive", "zip", "deflate", "window", "\n"}
+    var text bytes.Buffer
+    for text.Len() < 300<<10 {
+        text.WriteString(words[rnd.Intn(len(words))])
+        text.WriteByte(' ')
+    }
+    return map[string][]byte{
+        "empty":  nil,
+        "byte":   []byte("x"),
+        "text":   text.Bytes(),
+        "random": random,
+        "zeros":  make([]byte, 1<<20),
+        "mixed":  append(append([]byte(strings.Repeat("abc", 40000)), random[:70000]...), random[:70000]...),
+    }
+}
+
+// roundTrip compresses every input with comp and checks that decomp
+// restores it without reading past the end of the compressed data.
+func roundTrip(t *testing.T, comp func(io.Writer) (io.WriteCloser, error), decomp func(io.Reader) io.ReadCloser) {
+    for name, in := range compressInputs() {
+        var buf bytes.Buffer
+        w, err := comp(&buf)
+        if err != nil {
+            t.Fatal(err)
+        }
+        // Write in uneven pieces.
+        for p := in; len(p) > 0; {
+            n := len(p)
+            if n > 12345 {
+                n = 12345
+            }
+            if _, err := w.Write(p[:n]); err != nil {
+                t.Fatalf("%s: %v", name, err)
+            }
+            p = p[n:]
+        }
+        if err := w.Close(); err != nil {
+            t.Fatalf("%s: %v", name, err)
+        }
+        compressed := buf.Len()
+        buf.WriteByte('!')
+        out, err := ioutil.ReadAll(decomp(&buf))
+        if err != nil {
+            t.Fatalf("%s: %v", name, err)
+        }
+        if !bytes.Equal(out, in) {
+            t.Fatalf("%s: round trip mismatch: got %d bytes, want %d", name, len(out), len(in))
+        }
+        if buf.String() != "!" {
+            t.Errorf("%s: decompressor read past the end of its data", name)
+        }
+        t.Logf("%s: %d -> %d", name, len(in), compressed)
+    }
+}
+
+func TestDeflate64RoundTrip(t *testing.T) {
+    roundTrip(t, newDeflate64Writer, newDeflate64Reader)
+}
+
+func TestDeflate64LongMatch(t *testing.T) {
+    // Matches longer than 258 bytes use the 16 bit length extension,
+    // and distances beyond 32 KiB the two extra distance codes.
+    in := bytes.Repeat([]byte("0123456789"), 20000)
+    rand.New(rand.NewSource(2)).Read(in[:30000])
+    copy(in[60000:], in[:30000])
+    var buf bytes.Buffer
+    w, _ := newDeflate64Writer(&buf)
+    w.Write(in)
+    w.Close()
+    if buf.Len() > 31000 {
+        t.Errorf("compressed to %d bytes", buf.Len())
+    }
+    out, err := ioutil.ReadAll(newDeflate64Reader(&buf))
+    if err != nil || !bytes.Equal(out, in) {
+        t.Fatalf("round trip failed: %v", err)
+    }
+}
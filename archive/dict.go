@@ -0,0 +1,237 @@
+package archive
+
+import (
+    "archive/zip"
+    "compress/flate"
+    "container/heap"
+    "encoding/binary"
+    "errors"
+    "io"
+    "io/ioutil"
+)
+
+// Archives of many small, similar files compress much better when every
+// entry is deflated against a shared preset dictionary. The dictionary is
+// stored in the member DictionaryName, and the entries that use it have
+// method DictDeflate. Since that method is private to this package, other
+// tools report those entries as using an unsupported method.
+const (
+    DictionaryName        = ".archive-dictionary"
+    DictDeflate    uint16 = 0xdd01
+    // MaxDictionarySize is the largest useful dictionary: the deflate
+    // window.
+    MaxDictionarySize = 32 << 10
+)
+
+// ErrNoDictionary is returned by OpenDictZip when the archive has entries
+// compressed with DictDeflate but no dictionary member.
+var ErrNoDictionary = errors.New("archive: dictionary member missing")
+
+const (
+    dictGram    = 8  // length of the substrings counted
+    dictSegment = 48 // length of the pieces the dictionary is built from
+)
+
+// TrainDictionary builds a preset dictionary of at most size bytes from
+// sample contents. It collects the pieces of the samples that contain
+// the most substrings shared by several samples, placing the most
+// valuable last, where matches against them are cheapest. A size outside
+// (0, MaxDictionarySize] means MaxDictionarySize.
+func TrainDictionary(samples [][]byte, size int) []byte {
+    if size <= 0 || size > MaxDictionarySize {
+        size = MaxDictionarySize
+    }
+    // Count the samples each substring occurs in.
+    docs := make(map[uint64]int)
+    seen := make(map[uint64]bool)
+    for _, s := range samples {
+        for k := range seen {
+            delete(seen, k)
+        }
+        for i := 0; i+dictGram <= len(s); i++ {
+            g := binary.LittleEndian.Uint64(s[i:])
+            if !seen[g] {
+                seen[g] = true
+                docs[g]++
+            }
+        }
+    }
+    for g, n := range docs {
+        if n < 2 {
+            delete(docs, g)
+        }
+    }
+    score := func(seg []byte) int {
+        total := 0
+        for i := 0; i+dictGram <= len(seg); i++ {
+            total += docs[binary.LittleEndian.Uint64(seg[i:])]
+        }
+        return total
+    }
+    // Lazy greedy selection: a segment's score only drops as the
+    // substrings it covers are used up.
+    var h segmentHeap
+    for _, s := range samples {
+        for i := 0; i+dictGram <= len(s); i += dictSegment / 2 {
+            end := i + dictSegment
+            if end > len(s) {
+                end = len(s)
+            }
+            if sc := score(s[i:end]); sc > 0 {
+                h = append(h, dictSegmentScore{seg: s[i:end], score: sc})
+            }
+        }
+    }
+    heap.Init(&h)
+    var picked [][]byte
+    total := 0
+    for h.Len() > 0 && total < size {
+        top := heap.Pop(&h).(dictSegmentScore)
+        sc := score(top.seg)
+        if sc == 0 {
+            continue
+        }
+        if h.Len() > 0 && sc < h[0].score {
+            top.score = sc
+            heap.Push(&h, top)
+            continue
+        }
+        for i := 0; i+dictGram <= len(top.seg); i++ {
+            delete(docs, binary.LittleEndian.Uint64(top.seg[i:]))
+        }
+        picked = append(picked, top.seg)
+        total += len(top.seg)
+    }
+    dict := make([]byte, 0, total)
+    for i := len(picked) - 1; i >= 0; i-- {
+        dict = append(dict, picked[i]...)
+    }
+    if len(dict) > size {
+        dict = dict[len(dict)-size:]
+    }
+    return dict
+}
+
+type dictSegmentScore struct {
+    seg   []byte
+    score int
+}
+
+// segmentHeap is a max-heap of segments by score.
+type segmentHeap []dictSegmentScore
+
+func (h segmentHeap) Len() int            { return len(h) }
+func (h segmentHeap) Less(i, j int) bool  { return h[i].score > h[j].score }
+func (h segmentHeap) Swap(i, j int)       { h[i], h[j] = h[j], h[i] }
+func (h *segmentHeap) Push(x interface{}) { *h = append(*h, x.(dictSegmentScore)) }
+func (h *segmentHeap) Pop() interface{} {
+    old := *h
+    x := old[len(old)-1]
+    *h = old[:len(old)-1]
+    return x
+}
+
+// DictDecompressor returns a decompressor for DictDeflate entries
+// compressed against dict, for use with RegisterDecompressor.
+func DictDecompressor(dict []byte) zip.Decompressor {
+    return func(r io.Reader) io.ReadCloser { return flate.NewReaderDict(r, dict) }
+}
+
+// DictWriter writes a zip archive whose entries are deflated against a
+// preset dictionary.
+type DictWriter struct {
+    zw *zip.Writer
+}
+
+// NewDictWriter returns a DictWriter writing to w. The dictionary is
+// written as the first member.
+func NewDictWriter(w io.Writer, dict []byte) (*DictWriter, error) {
+    if len(dict) > MaxDictionarySize {
+        dict = dict[len(dict)-MaxDictionarySize:]
+    }
+    zw := newZipWriter(w)
+    zw.RegisterCompressor(DictDeflate, func(out io.Writer) (io.WriteCloser, error) {
+        return flate.NewWriterDict(out, flate.BestCompression, dict)
+    })
+    fw, err := zw.CreateHeader(&zip.FileHeader{Name: DictionaryName, Method: zip.Deflate})
+    if err != nil {
+        return nil, err
+    }
+    if _, err := fw.Write(dict); err != nil {
+        return nil, err
+    }
+    return &DictWriter{zw: zw}, nil
+}
+
+// Create adds a file compressed against the dictionary.
+func (w *DictWriter) Create(name string) (io.Writer, error) {
+    return w.CreateHeader(&zip.FileHeader{Name: name})
+}
+
+// CreateHeader adds an entry described by fh. Entries with content are
+// compressed against the dictionary; directories are stored.
+func (w *DictWriter) CreateHeader(fh *zip.FileHeader) (io.Writer, error) {
+    if fh.Mode().IsRegular() {
+        fh.Method = DictDeflate
+    } else {
+        fh.Method = zip.Store
+    }
+    setUTF8Flag(fh)
+    setZipModified(fh, fh.Modified)
+    return w.zw.CreateHeader(fh)
+}
+
+// SetComment sets the archive comment.
+func (w *DictWriter) SetComment(comment string) error { return w.zw.SetComment(comment) }
+
+// Close finishes the archive. It does not close the underlying writer.
+func (w *DictWriter) Close() error { return w.zw.Close() }
+
+// OpenDictZip opens the zip archive in r, registering the decompressor
+// for its dictionary compressed entries if it has any. Archives without
+// such entries are opened as by zip.NewReader.
+func OpenDictZip(r io.ReaderAt, size int64) (*zip.Reader, error) {
+    zr, err := newZipReader(r, size)
+    if err != nil {
+        return nil, err
+    }
+    if _, err := registerDictionary(zr); err != nil {
+        return nil, err
+    }
+    return zr, nil
+}
+
+// registerDictionary registers the DictDeflate decompressor with zr if
+// the archive uses it, and reports whether it does.
+func registerDictionary(zr *zip.Reader) (bool, error) {
+    var member *zip.File
+    used := false
+    for _, f := range zr.File {
+        switch {
+        case f.Name == DictionaryName:
+            member = f
+        case f.Method == DictDeflate:
+            used = true
+        }
+    }
+    if !used {
+        return false, nil
+    }
+    if member == nil {
+        return true, ErrNoDictionary
+    }
+    if member.UncompressedSize64 > MaxDictionarySize {
+        return true, &EntryError{Name: member.Name, Err: errors.New("dictionary too large")}
+    }
+    rc, err := member.Open()
+    if err != nil {
+        return true, &EntryError{Name: member.Name, Err: err}
+    }
+    defer rc.Close()
+    dict, err := ioutil.ReadAll(io.LimitReader(rc, MaxDictionarySize))
+    if err != nil {
+        return true, &EntryError{Name: member.Name, Err: err}
+    }
+    zr.RegisterDecompressor(DictDeflate, DictDecompressor(dict))
+    return true, nil
+}
@@ -0,0 +1,118 @@
+package archive
+
+import (
+    "archive/zip"
+    "bytes"
+    "compress/flate"
+    "context"
+    "fmt"
+    "io"
+    "io/ioutil"
+    "math/rand"
+    "path/filepath"
+    "testing"
+)
+
+// jsonDocs returns small JSON documents sharing their structure.
+func jsonDocs(n int) map[string][]byte {
+    rnd := rand.New(rand.NewSource(1))
+    cities := []string{"Amsterdam", "Berlin", "Chicago", "Denver", "Edinburgh"}
+    docs := make(map[string][]byte)
+    for i := 0; i < n; i++ {
+        docs[fmt.Sprintf("doc/%04d.json", i)] = []byte(fmt.Sprintf(
+            `{"id":%d,"customer":{"name":"customer-%d","city":%q,"active":%t},`+
+                `"order":{"items":[{"sku":"SKU-%05d","quantity":%d,"currency":"EUR"}],`+
+                `"status":"shipped","carrier":"express"}}`,
+            i, rnd.Intn(1000), cities[rnd.Intn(len(cities))], rnd.Intn(2) == 0,
+            rnd.Intn(100000), rnd.Intn(9)+1))
+    }
+    return docs
+}
+
+func TestDictionaryZip(t *testing.T) {
+    docs := jsonDocs(500)
+    var samples [][]byte
+    for _, d := range docs {
+        if len(samples) < 100 {
+            samples = append(samples, d)
+        }
+    }
+    dict := TrainDictionary(samples, 4096)
+    if len(dict) == 0 || len(dict) > 4096 {
+        t.Fatalf("dictionary of %d bytes", len(dict))
+    }
+
+    var buf bytes.Buffer
+    w, err := NewDictWriter(&buf, dict)
+    if err != nil {
+        t.Fatal(err)
+    }
+    var plain int
+    for name, d := range docs {
+        fw, err := w.Create(name)
+        if err != nil {
+            t.Fatal(err)
+        }
+        fw.Write(d)
+        var c bytes.Buffer
+        fw2, _ := flate.NewWriter(&c, flate.BestCompression)
+        fw2.Write(d)
+        fw2.Close()
+        plain += c.Len()
+    }
+    if err := w.Close(); err != nil {
+        t.Fatal(err)
+    }
+
+    zr, err := OpenDictZip(bytes.NewReader(buf.Bytes()), int64(buf.Len()))
+    if err != nil {
+        t.Fatal(err)
+    }
+    var withDict int
+    for _, f := range zr.File {
+        if f.Name == DictionaryName {
+            continue
+        }
+        withDict += int(f.CompressedSize64)
+        rc, err := f.Open()
+        if err != nil {
+            t.Fatal(err)
+        }
+        b, err := ioutil.ReadAll(rc)
+        rc.Close()
+        if err != nil || !bytes.Equal(b, docs[f.Name]) {
+            t.Fatalf("%s: content mismatch: %v", f.Name, err)
+        }
+    }
+    t.Logf("entries: %d bytes deflated alone, %d with a %d byte dictionary", plain, withDict, len(dict))
+    if withDict*2 > plain {
+        t.Errorf("dictionary compression saved too little: %d vs %d", withDict, plain)
+    }
+
+    // Extraction decompresses transparently and leaves the dictionary out.
+    dir := tempDir(t)
+    if err := ExtractZip(context.Background(), bytes.NewReader(buf.Bytes()), int64(buf.Len()), dir, nil); err != nil {
+        t.Fatal(err)
+    }
+    b, err := ioutil.ReadFile(filepath.Join(dir, "doc", "0007.json"))
+    if err != nil || !bytes.Equal(b, docs["doc/0007.json"]) {
+        t.Errorf("extracted doc/0007.json: %v", err)
+    }
+    if _, err := ioutil.ReadFile(filepath.Join(dir, DictionaryName)); err == nil {
+        t.Errorf("dictionary member was extracted")
+    }
+}
+
+func TestDictionaryMissing(t *testing.T) {
+    var buf bytes.Buffer
+    zw := zip.NewWriter(&buf)
+    zw.RegisterCompressor(DictDeflate, func(out io.Writer) (io.WriteCloser, error) {
+        return flate.NewWriterDict(out, flate.BestCompression, []byte("dictionary"))
+    })
+    fw, _ := zw.CreateHeader(&zip.FileHeader{Name: "a.json", Method: DictDeflate})
+    fw.Write([]byte("dictionary dictionary"))
+    zw.Close()
+    if _, err := OpenDictZip(bytes.NewReader(buf.Bytes()), int64(buf.Len())); err != ErrNoDictionary {
+        t.Errorf("got %v, want %v", err, ErrNoDictionary)
+    }
+}
@@ -0,0 +1,510 @@
+package archive
+
+import (
+    "archive/tar"
+    "archive/zip"
+    "context"
+    "errors"
+    "fmt"
+    "io"
+    "io/ioutil"
+    "os"
+    "path/filepath"
+    "sort"
+    "strings"
+    "sync"
+    "time"
+)
+
+// ExtractOptions configures Extract, ExtractTar and ExtractZip.
+type ExtractOptions struct {
+    // Progress, if set, receives progress reports.
+    Progress ProgressFunc
+    // Concurrency is the number of zip entries decompressed and written
+    // at once. Values below 2 extract one entry at a time. Tar, ar and
+    // cpio streams are always extracted sequentially.
+    Concurrency int
+    // Charset is the character set of names that are neither flagged
+    // nor valid as UTF-8. Zip entries with an Info-ZIP Unicode Path extra
+    // field take their names from it. The zero value, CharsetNone, uses
+    // names as they are stored.
+    Charset Charset
+    // NormalizeNames converts names to Unicode Normalization Form C, so
+    // that names in the decomposed form macOS writes extract to the same
+    // files as their composed equivalents.
+    NormalizeNames bool
+
+    // Existing decides what happens to entries whose target exists and
+    // is not a directory. The zero value overwrites it.
+    Existing ExistingPolicy
+    // Clash decides what happens when a directory entry meets another
+    // kind of file at its path, or the reverse. The zero value fails.
+    Clash ClashPolicy
+    // Owner decides whether the owners recorded in the archive are
+    // restored. The zero value leaves files to the extracting user.
+    Owner OwnerPolicy
+    // UIDMap and GIDMap translate the user and group ids restored, after
+    // any lookup by name.
+    UIDMap, GIDMap map[int]int
+    // Umask holds permission bits cleared from the mode of every file
+    // and directory extracted.
+    Umask os.FileMode
+    // StripSetID clears the setuid and setgid bits of extracted files
+    // and directories.
+    StripSetID bool
+    // Report, if set, is called with each decision taken, as the
+    // extraction goes. Calls are made one at a time.
+    Report func(ExtractDecision)
+}
+
+// Extract unpacks the archive file src into the directory dst. If the
+// operation fails or ctx is cancelled, everything it created is removed.
+func Extract(ctx context.Context, src, dst string, opts *ExtractOptions) error {
+    f, err := os.Open(src)
+    if err != nil {
+        return err
+    }
+    defer f.Close()
+    fi, err := f.Stat()
+    if err != nil {
+        return err
+    }
+    format := DetectFormat(f)
+    if format == FormatUnknown {
+        format = FormatFromName(src)
+    }
+    switch format {
+    case FormatZip:
+        return ExtractZip(ctx, f, fi.Size(), dst, opts)
+    case FormatTar:
+        return ExtractTar(ctx, f, dst, opts)
+    case FormatAr:
+        return extractEntries(ctx, f, func(r io.Reader) EntryReader { return NewArReader(r) }, dst, opts)
+    case FormatCpio:
+        return extractEntries(ctx, f, func(r io.Reader) EntryReader { return NewCpioReader(r) }, dst, opts)
+    }
+    return ErrFormat
+}
+
+// ExtractTar unpacks the tar stream r into the directory dst.
+func ExtractTar(ctx context.Context, r io.Reader, dst string, opts *ExtractOptions) error {
+    return extractEntries(ctx, r, func(r io.Reader) EntryReader { return tar.NewReader(r) }, dst, opts)
+}
+
+// extractEntries unpacks a sequential archive read through newReader.
+func extractEntries(ctx context.Context, r io.Reader, newReader func(io.Reader) EntryReader, dst string, opts *ExtractOptions) (err error) {
+    if opts == nil {
+        opts = &ExtractOptions{}
+    }
+    tk := newTracker(opts.Progress, -1, readerSize(r))
+    x := newExtractor(ctx, dst, opts, tk)
+    defer func() {
+        if err != nil {
+            x.cleanup()
+        }
+    }()
+    tr := newReader(&ctxReader{ctx: ctx, r: r, count: tk.addIn})
+    for {
+        hdr, err := tr.Next()
+        if err == io.EOF {
+            break
+        }
+        if err != nil {
+            return err
+        }
+        if opts.Charset != CharsetNone {
+            decodeNames(hdr, opts.Charset)
+        }
+        tk.begin(hdr.Name)
+        if err := x.extract(hdr, tr); err != nil {
+            return err
+        }
+        tk.entryDone()
+    }
+    if err := x.finish(); err != nil {
+        return err
+    }
+    tk.done()
+    return nil
+}
+
+// SkippedError is returned when extraction completed except for entries
+// compressed with unsupported methods. Everything else is kept.
+type SkippedError struct {
+    Entries []*EntryError
+}
+
+func (e *SkippedError) Error() string {
+    if len(e.Entries) == 1 {
+        return e.Entries[0].Error()
+    }
+    return fmt.Sprintf("%v (and %d more skipped entries)", e.Entries[0], len(e.Entries)-1)
+}
+
+// Unwrap returns the first entry's error, so errors.As finds its
+// *MethodError.
+func (e *SkippedError) Unwrap() error { return e.Entries[0] }
+
+// ExtractZip unpacks the zip archive in r into the directory dst. Entries
+// with an unsupported compression method are skipped and reported in a
+// *SkippedError once the rest has been extracted.
+func ExtractZip(ctx context.Context, r io.ReaderAt, size int64, dst string, opts *ExtractOptions) (err error) {
+    if opts == nil {
+        opts = &ExtractOptions{}
+    }
+    tk := newTracker(opts.Progress, -1, size)
+    zr, err := newZipReader(&countReaderAt{ctx: ctx, r: r, count: tk.addIn}, size)
+    if err != nil {
+        return err
+    }
+    // Entries compressed against a preset dictionary are decompressed
+    // transparently, and the dictionary itself is not extracted. Without
+    // it they are skipped as unsupported.
+    dict, err := registerDictionary(zr)
+    if err != nil && err != ErrNoDictionary {
+        return err
+    }
+    if opts.Charset != CharsetNone {
+        DecodeZipNames(zr, opts.Charset)
+    }
+    tk.mu.Lock()
+    tk.p.TotalEntries = len(zr.File)
+    tk.mu.Unlock()
+    x := newExtractor(ctx, dst, opts, tk)
+    x.src, _ = r.(*os.File)
+    defer func() {
+        if _, ok := err.(*SkippedError); err != nil && !ok {
+            x.cleanup()
+        }
+    }()
+    files := zr.File
+    if dict {
+        files = nil
+        for _, f := range zr.File {
+            if f.Name == DictionaryName {
+                tk.entryDone()
+                continue
+            }
+            files = append(files, f)
+        }
+    }
+    var skipped []*EntryError
+    if opts.Concurrency > 1 {
+        skipped, err = extractZipParallel(x, files, opts.Concurrency)
+    } else {
+        skipped, err = extractZipFiles(x, files)
+    }
+    if err != nil {
+        return err
+    }
+    if err := x.finish(); err != nil {
+        return err
+    }
+    tk.done()
+    if len(skipped) > 0 {
+        return &SkippedError{Entries: skipped}
+    }
+    return nil
+}
+
+// extractZipFiles extracts zip entries one at a time, returning those
+// skipped for their compression method.
+func extractZipFiles(x *extractor, files []*zip.File) ([]*EntryError, error) {
+    var skipped []*EntryError
+    for _, f := range files {
+        if err := x.ctx.Err(); err != nil {
+            return nil, err
+        }
+        x.tk.begin(f.Name)
+        open := func() (io.ReadCloser, error) { return x.openZip(f) }
+        if err := extractZipEntry(x, &f.FileHeader, open); err != nil {
+            ee, ok := skippable(err)
+            if !ok {
+                return nil, err
+            }
+            skipped = append(skipped, ee)
+        }
+        x.tk.entryDone()
+    }
+    return skipped, nil
+}
+
+// skippable returns the entry error of err if it only reports an
+// unsupported compression method.
+func skippable(err error) (*EntryError, bool) {
+    var me *MethodError
+    var ee *EntryError
+    if !errors.As(err, &me) || !errors.As(err, &ee) {
+        return nil, false
+    }
+    return ee, true
+}
+
+// extractZipEntry extracts the zip entry described by fh, whose content
+// is read through open.
+func extractZipEntry(x *extractor, fh *zip.FileHeader, open func() (io.ReadCloser, error)) error {
+    hdr := zipHeader(fh)
+    rc, err := open()
+    if err == zip.ErrAlgorithm {
+        err = &MethodError{Method: fh.Method}
+    }
+    if err != nil {
+        return &EntryError{Name: fh.Name, Err: err}
+    }
+    defer rc.Close()
+    if hdr.Typeflag == tar.TypeSymlink {
+        link, err := ioutil.ReadAll(io.LimitReader(rc, 4096))
+        if err != nil {
+            return &EntryError{Name: fh.Name, Err: err}
+        }
+        hdr.Linkname = string(link)
+    }
+    return x.extract(hdr, rc)
+}
+
+// readerSize returns the total size of r when it can be known up front.
+func readerSize(r io.Reader) int64 {
+    switch v := r.(type) {
+    case interface{ Size() int64 }:
+        return v.Size()
+    case *os.File:
+        if fi, err := v.Stat(); err == nil && fi.Mode().IsRegular() {
+            return fi.Size()
+        }
+    }
+    return -1
+}
+
+// dirMeta is directory metadata applied once all entries are written,
+// since writing files into a directory changes its modification time.
+type dirMeta struct {
+    path  string
+    mode  os.FileMode
+    mtime time.Time
+    uid   int
+    gid   int
+}
+
+// extractor writes entries below a destination directory and remembers
+// what it created so a failed extraction can be rolled back.
+type extractor struct {
+    ctx     context.Context
+    dst     string
+    opts    *ExtractOptions
+    tk      *tracker
+    src     *os.File   // the zip archive file, for kernel copies
+    mu      sync.Mutex // guards created while workers write files
+    created []string
+    dirs    []dirMeta
+    ids     map[string]int       // looked up user and group ids
+    planned map[string]time.Time // files due to be written by workers
+}
+
+func newExtractor(ctx context.Context, dst string, opts *ExtractOptions, tk *tracker) *extractor {
+    return &extractor{ctx: ctx, dst: dst, opts: opts, tk: tk}
+}
+
+// target resolves an entry name to a path below x.dst, refusing names that
+// escape it either lexically or through an existing symlink.
+func (x *extractor) target(name string) (string, error) {
+    clean, err := cleanName(name)
+    if err != nil {
+        return "", &EntryError{Name: name, Err: err}
+    }
+    if clean == "." {
+        return "", nil
+    }
+    if x.opts.NormalizeNames {
+        clean = nfc(clean)
+    }
+    p := x.dst
+    parts := strings.Split(clean, "/")
+    for _, part := range parts[:len(parts)-1] {
+        p = filepath.Join(p, part)
+        if fi, err := os.Lstat(p); err == nil && fi.Mode()&os.ModeSymlink != 0 {
+            return "", &EntryError{Name: name, Err: ErrInsecurePath}
+        }
+    }
+    return filepath.Join(x.dst, filepath.FromSlash(clean)), nil
+}
+
+// mkdirAll is os.MkdirAll that records the directories it creates and
+// applies the clash policy of hdr to files in the way below x.dst. It
+// returns errSkipEntry if hdr is to be skipped.
+func (x *extractor) mkdirAll(hdr *tar.Header, dir string) error {
+    fi, err := os.Stat(dir)
+    if err == nil {
+        if fi.IsDir() {
+            return nil
+        }
+        if !x.below(dir) {
+            return &os.PathError{Op: "mkdir", Path: dir, Err: os.ErrExist}
+        }
+        if err := x.clash(hdr, dir, "a file"); err != nil {
+            return err
+        }
+    }
+    if parent := filepath.Dir(dir); parent != dir {
+        if err := x.mkdirAll(hdr, parent); err != nil {
+            return err
+        }
+    }
+    if err := os.Mkdir(dir, 0755); err != nil {
+        if os.IsExist(err) {
+            return nil
+        }
+        return err
+    }
+    x.created = append(x.created, dir)
+    return nil
+}
+
+func (x *extractor) extract(hdr *tar.Header, r io.Reader) error {
+    if err := x.ctx.Err(); err != nil {
+        return err
+    }
+    target, err := x.target(hdr.Name)
+    if err != nil || target == "" {
+        return err
+    }
+    if err := x.mkdirAll(hdr, filepath.Dir(target)); err != nil {
+        if err == errSkipEntry {
+            return nil
+        }
+        return err
+    }
+    switch hdr.Typeflag {
+    case tar.TypeDir:
+        return x.extractDir(hdr, target)
+    case tar.TypeReg, tar.TypeRegA, tar.TypeSymlink, tar.TypeLink:
+    default:
+        // Devices, fifos and vendor specific types are not materialized.
+        x.record(x.decision(hdr, target, ActionSkipped, "not a file, directory or link"))
+        return nil
+    }
+    target, d, err := x.place(hdr, target)
+    if err != nil || target == "" {
+        if err == errSkipEntry {
+            return nil
+        }
+        return err
+    }
+    switch hdr.Typeflag {
+    case tar.TypeSymlink:
+        link := hdr.Linkname
+        if x.opts.NormalizeNames {
+            link = nfc(link)
+        }
+        if err := os.Symlink(link, target); err != nil {
+            return err
+        }
+        x.created = append(x.created, target)
+        return setOwner(target, &d)
+    case tar.TypeLink:
+        old, err := x.target(hdr.Linkname)
+        if err != nil {
+            return err
+        }
+        if err := os.Link(old, target); err != nil {
+            return err
+        }
+        x.created = append(x.created, target)
+        return nil
+    }
+    return x.writeFile(target, hdr, &d, r)
+}
+
+// extractDir creates the directory of hdr, or takes over an existing one.
+// Its mode, owner and times are set by finish.
+func (x *extractor) extractDir(hdr *tar.Header, target string) error {
+    action := ActionCreated
+    fi, err := os.Lstat(target)
+    switch {
+    case err == nil && fi.IsDir():
+        action = ActionMerged
+    case err == nil:
+        if err := x.clash(hdr, target, "a file"); err != nil {
+            if err == errSkipEntry {
+                return nil
+            }
+            return err
+        }
+    case !os.IsNotExist(err):
+        return err
+    }
+    if err := x.mkdirAll(hdr, target); err != nil {
+        if err == errSkipEntry {
+            return nil
+        }
+        return err
+    }
+    d := x.decision(hdr, target, action)
+    x.record(d)
+    x.dirs = append(x.dirs, dirMeta{path: target, mode: d.Mode, mtime: hdr.ModTime, uid: d.UID, gid: d.GID})
+    return nil
+}
+
+func (x *extractor) writeFile(target string, hdr *tar.Header, d *ExtractDecision, r io.Reader) error {
+    fi, statErr := os.Lstat(target)
+    if statErr == nil && !fi.Mode().IsRegular() {
+        // Opening a symlink left by an earlier entry would write through
+        // it, possibly outside dst.
+        if err := os.Remove(target); err != nil {
+            return err
+        }
+    }
+    f, err := os.OpenFile(target, os.O_CREATE|os.O_TRUNC|os.O_WRONLY, 0600)
+    if err != nil {
+        return err
+    }
+    if os.IsNotExist(statErr) {
+        x.created = append(x.created, target)
+    }
+    err = x.copyTo(x.ctx, f, r)
+    if cerr := f.Close(); err == nil {
+        err = cerr
+    }
+    if err != nil {
+        return err
+    }
+    // Changing the owner clears the setuid and setgid bits.
+    if err := setOwner(target, d); err != nil {
+        return err
+    }
+    if err := os.Chmod(target, d.Mode); err != nil {
+        return err
+    }
+    if !hdr.ModTime.IsZero() {
+        return os.Chtimes(target, hdr.ModTime, hdr.ModTime)
+    }
+    return nil
+}
+
+// finish applies directory metadata, deepest directories first.
+func (x *extractor) finish() error {
+    sort.SliceStable(x.dirs, func(i, j int) bool { return x.dirs[i].path > x.dirs[j].path })
+    for _, d := range x.dirs {
+        if d.uid >= 0 || d.gid >= 0 {
+            if err := os.Lchown(d.path, d.uid, d.gid); err != nil {
+                return err
+            }
+        }
+        if err := os.Chmod(d.path, d.mode); err != nil {
+            return err
+        }
+        if !d.mtime.IsZero() {
+            if err := os.Chtimes(d.path, d.mtime, d.mtime); err != nil {
+                return err
+            }
+        }
+    }
+    return nil
+}
+
+// cleanup removes everything the extractor created, newest first.
+func (x *extractor) cleanup() {
+    for i := len(x.created) - 1; i >= 0; i-- {
+        os.RemoveAll(x.created[i])
+    }
+    x.created = nil
+}
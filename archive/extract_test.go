@@ -0,0 +1,100 @@
+package archive
+
+import (
+    "archive/tar"
+    "bytes"
+    "context"
+    "io/ioutil"
+    "os"
+    "path/filepath"
+    "testing"
+)
+
+func TestExtractRoundTrip(t *testing.T) {
+    src := tempDir(t)
+    writeTree(t, src, treeFiles)
+    for _, name := range []string{"out.tar", "out.zip"} {
+        arc := filepath.Join(tempDir(t), name)
+        if err := Create(context.Background(), arc, src, nil); err != nil {
+            t.Fatal(err)
+        }
+        dst := tempDir(t)
+        if err := Extract(context.Background(), arc, dst, nil); err != nil {
+            t.Fatalf("%s: %v", name, err)
+        }
+        checkTree(t, dst, treeFiles)
+    }
+}
+
+func TestExtractCancelCleansUp(t *testing.T) {
+    src := tempDir(t)
+    writeTree(t, src, treeFiles)
+    for _, name := range []string{"out.tar", "out.zip"} {
+        arc := filepath.Join(tempDir(t), name)
+        if err := Create(context.Background(), arc, src, nil); err != nil {
+            t.Fatal(err)
+        }
+        dst := tempDir(t)
+        ctx, cancel := context.WithCancel(context.Background())
+        opts := &ExtractOptions{Progress: func(p Progress) {
+            if p.Entries == 3 {
+                cancel()
+            }
+        }}
+        if err := Extract(ctx, arc, dst, opts); err != context.Canceled {
+            t.Fatalf("%s: Extract = %v, want context.Canceled", name, err)
+        }
+        checkTree(t, dst, nil)
+    }
+}
+
+func TestExtractInsecurePath(t *testing.T) {
+    x := newExtractor(context.Background(), tempDir(t), &ExtractOptions{}, newTracker(nil, 0, 0))
+    for _, name := range []string{"../evil", "/etc/passwd", "a/../../evil"} {
+        if _, err := x.target(name); err == nil {
+            t.Errorf("target(%q) succeeded", name)
+        }
+    }
+    if err := os.Symlink("/tmp", filepath.Join(x.dst, "link")); err != nil {
+        t.Skip(err)
+    }
+    if _, err := x.target("link/evil"); err == nil {
+        t.Error("target through symlink succeeded")
+    }
+}
+
+func TestExtractOverSymlink(t *testing.T) {
+    // A symlink entry followed by a file of the same name must replace
+    // the link, not write through it.
+    outside := filepath.Join(tempDir(t), "victim")
+    if err := ioutil.WriteFile(outside, []byte("precious"), 0644); err != nil {
+        t.Fatal(err)
+    }
+    var buf bytes.Buffer
+    tw := tar.NewWriter(&buf)
+    tw.WriteHeader(&tar.Header{Name: "a", Typeflag: tar.TypeSymlink, Linkname: outside})
+    tw.WriteHeader(&tar.Header{Name: "a", Typeflag: tar.TypeReg, Mode: 0644, Size: 5})
+    tw.Write([]byte("owned"))
+    tw.Close()
+    dst := tempDir(t)
+    if err := ExtractTar(context.Background(), &buf, dst, nil); err != nil {
+        t.Fatal(err)
+    }
+    checkTree(t, dst, map[string]string{"a": "owned"})
+
+    // The writer checks for itself, whatever the policy decided.
+    x := newExtractor(context.Background(), dst, &ExtractOptions{}, newTracker(nil, 0, 0))
+    link := filepath.Join(dst, "b")
+    if err := os.Symlink(outside, link); err != nil {
+        t.Fatal(err)
+    }
+    hdr := &tar.Header{Name: "b", Typeflag: tar.TypeReg, Mode: 0644}
+    d := x.decision(hdr, link, ActionOverwritten)
+    if err := x.writeFile(link, hdr, &d, bytes.NewReader([]byte("owned"))); err != nil {
+        t.Fatal(err)
+    }
+    if b, _ := ioutil.ReadFile(outside); string(b) != "precious" {
+        t.Errorf("file outside dst = %q", b)
+    }
+    checkTree(t, dst, map[string]string{"a": "owned", "b": "owned"})
+}
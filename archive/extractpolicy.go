@@ -0,0 +1,300 @@
+package archive
+
+import (
+    "archive/tar"
+    "errors"
+    "fmt"
+    "os"
+    "os/user"
+    "path/filepath"
+    "strconv"
+    "strings"
+    "time"
+)
+
+// ExistingPolicy decides what Extract does with an entry whose target
+// already exists and is not a directory. Existing directories are used as
+// they are, whatever the policy.
+type ExistingPolicy int
+
+const (
+    // Overwrite replaces the existing file.
+    Overwrite ExistingPolicy = iota
+    // SkipExisting keeps the existing file and skips the entry.
+    SkipExisting
+    // KeepNewer replaces the existing file only if the entry was
+    // modified after it.
+    KeepNewer
+    // RenameNew extracts the entry beside the existing file under a
+    // numbered name, as Merge does with RenameConflicts: a.txt becomes
+    // a~2.txt, then a~3.txt.
+    RenameNew
+    // FailExisting fails the extraction with an error wrapping
+    // os.ErrExist.
+    FailExisting
+)
+
+// ClashPolicy decides what Extract does when an entry is a directory and
+// its path exists as something else, or the reverse.
+type ClashPolicy int
+
+const (
+    // ClashError fails the extraction with ErrClash.
+    ClashError ClashPolicy = iota
+    // ClashReplace removes what is in the way, a directory with
+    // everything in it.
+    ClashReplace
+    // ClashSkip keeps what is in the way and skips the entry, and the
+    // entries below a skipped directory.
+    ClashSkip
+)
+
+// ErrClash is returned by Extract with the ClashError policy when a
+// directory and another kind of file would have the same path.
+var ErrClash = errors.New("archive: a directory and a file have the same path")
+
+// OwnerPolicy decides whether Extract restores the owners recorded in the
+// archive. Giving files away usually takes privileges.
+type OwnerPolicy int
+
+const (
+    // OwnerNone leaves extracted files to the extracting user.
+    OwnerNone OwnerPolicy = iota
+    // OwnerNumeric restores the recorded user and group ids.
+    OwnerNumeric
+    // OwnerByName restores the users and groups named in the archive as
+    // they are known on this system, or the recorded ids for names it
+    // does not know.
+    OwnerByName
+)
+
+// ExtractAction is what Extract did with an entry.
+type ExtractAction string
+
+const (
+    // ActionCreated means the entry was written where nothing was.
+    ActionCreated ExtractAction = "created"
+    // ActionOverwritten means the entry replaced an existing file.
+    ActionOverwritten ExtractAction = "overwritten"
+    // ActionReplaced means a directory or file in the way of the entry,
+    // of the other kind, was removed.
+    ActionReplaced ExtractAction = "replaced"
+    // ActionMerged means a directory entry was extracted onto an existing
+    // directory.
+    ActionMerged ExtractAction = "merged"
+    // ActionRenamed means the entry was written under a numbered name.
+    ActionRenamed ExtractAction = "renamed"
+    // ActionSkipped means the entry was not extracted.
+    ActionSkipped ExtractAction = "skipped"
+)
+
+// ExtractDecision records what Extract did with an entry and why. An
+// entry that needed something removed from its way has a decision for
+// that before its own.
+type ExtractDecision struct {
+    Name string
+    // Path is the file written or, for skipped and replaced entries,
+    // the one in the way.
+    Path   string
+    Action ExtractAction
+    // Mode is the mode given to the file, after Umask and StripSetID.
+    Mode os.FileMode
+    // UID and GID are the owner given to the file, or -1 when owners
+    // are not restored.
+    UID, GID int
+    Reason   string
+}
+
+// errSkipEntry stops the extraction of an entry skipped by a policy.
+var errSkipEntry = errors.New("archive: entry skipped")
+
+// record reports a decision. Reports are made one at a time.
+func (x *extractor) record(d ExtractDecision) {
+    if x.opts.Report == nil {
+        return
+    }
+    x.mu.Lock()
+    defer x.mu.Unlock()
+    x.opts.Report(d)
+}
+
+// decision describes the extraction of hdr to path, with the mode and
+// owner it will be given unless it is skipped.
+func (x *extractor) decision(hdr *tar.Header, path string, action ExtractAction, reasons ...string) ExtractDecision {
+    d := ExtractDecision{Name: hdr.Name, Path: path, Action: action, UID: -1, GID: -1}
+    if action != ActionSkipped && action != ActionReplaced {
+        d.Mode = tarMode(hdr)
+        if x.opts.StripSetID && d.Mode&(os.ModeSetuid|os.ModeSetgid) != 0 {
+            d.Mode &^= os.ModeSetuid | os.ModeSetgid
+            reasons = append(reasons, "setuid and setgid bits stripped")
+        }
+        d.Mode &^= x.opts.Umask & os.ModePerm
+        if hdr.Typeflag != tar.TypeLink {
+            // A hard link shares the owner of its target.
+            d.UID, d.GID, reasons = x.owner(hdr, reasons)
+        }
+    }
+    d.Reason = strings.Join(reasons, "; ")
+    return d
+}
+
+// owner returns the owner to give the file of hdr.
+func (x *extractor) owner(hdr *tar.Header, reasons []string) (int, int, []string) {
+    uid, gid := hdr.Uid, hdr.Gid
+    switch x.opts.Owner {
+    case OwnerNone:
+        return -1, -1, reasons
+    case OwnerByName:
+        var ok bool
+        if uid, ok = x.lookupID("user", hdr.Uname, hdr.Uid); !ok {
+            reasons = append(reasons, fmt.Sprintf("no user %s, kept uid %d", hdr.Uname, uid))
+        }
+        if gid, ok = x.lookupID("group", hdr.Gname, hdr.Gid); !ok {
+            reasons = append(reasons, fmt.Sprintf("no group %s, kept gid %d", hdr.Gname, gid))
+        }
+    }
+    if id, ok := x.opts.UIDMap[uid]; ok {
+        uid = id
+    }
+    if id, ok := x.opts.GIDMap[gid]; ok {
+        gid = id
+    }
+    return uid, gid, reasons
+}
+
+// lookupID returns the id of the named user or group, or def with false
+// when the name is empty or unknown. Answers are cached.
+func (x *extractor) lookupID(kind, name string, def int) (int, bool) {
+    if name == "" {
+        return def, true
+    }
+    key := kind + ":" + name
+    if id, ok := x.ids[key]; ok {
+        if id < 0 {
+            return def, false
+        }
+        return id, true
+    }
+    id := -1
+    if kind == "user" {
+        if u, err := user.Lookup(name); err == nil {
+            id, _ = strconv.Atoi(u.Uid)
+        }
+    } else if g, err := user.LookupGroup(name); err == nil {
+        id, _ = strconv.Atoi(g.Gid)
+    }
+    if x.ids == nil {
+        x.ids = map[string]int{}
+    }
+    x.ids[key] = id
+    if id < 0 {
+        return def, false
+    }
+    return id, true
+}
+
+// setOwner gives path the owner of d, without following a symlink.
+func setOwner(path string, d *ExtractDecision) error {
+    if d.UID < 0 && d.GID < 0 {
+        return nil
+    }
+    return os.Lchown(path, d.UID, d.GID)
+}
+
+// below reports whether path is inside the destination.
+func (x *extractor) below(path string) bool {
+    rel, err := filepath.Rel(x.dst, path)
+    return err == nil && rel != "." && rel != ".." && !strings.HasPrefix(rel, ".."+string(filepath.Separator))
+}
+
+// clash applies the clash policy to what is at path in the way of the
+// entry hdr. It returns errSkipEntry if the entry is to be skipped.
+func (x *extractor) clash(hdr *tar.Header, path string, what string) error {
+    switch x.opts.Clash {
+    case ClashReplace:
+        if err := os.RemoveAll(path); err != nil {
+            return err
+        }
+        x.record(x.decision(hdr, path, ActionReplaced, "removed "+what+" in the way"))
+        return nil
+    case ClashSkip:
+        x.record(x.decision(hdr, path, ActionSkipped, what+" in the way"))
+        return errSkipEntry
+    }
+    return &EntryError{Name: hdr.Name, Err: &os.PathError{Op: "extract", Path: path, Err: ErrClash}}
+}
+
+// place decides where the entry hdr, which is not a directory, goes: to
+// target, to a numbered name beside it, or nowhere. It clears the way
+// unless a regular file is replaced by another, which the writer does in
+// one step.
+func (x *extractor) place(hdr *tar.Header, target string) (string, ExtractDecision, error) {
+    var d ExtractDecision
+    fi, err := os.Lstat(target)
+    if err != nil && !os.IsNotExist(err) {
+        return "", d, err
+    }
+    exists := err == nil
+    regular := exists && fi.Mode().IsRegular()
+    var mtime time.Time
+    if exists {
+        mtime = fi.ModTime()
+    }
+    if t, ok := x.planned[target]; ok {
+        exists, regular, mtime = true, true, t
+    }
+    if !exists {
+        d = x.decision(hdr, target, ActionCreated)
+        x.record(d)
+        return target, d, nil
+    }
+    if fi != nil && fi.IsDir() {
+        if err := x.clash(hdr, target, "a directory"); err != nil {
+            return "", d, err
+        }
+        d = x.decision(hdr, target, ActionCreated)
+        x.record(d)
+        return target, d, nil
+    }
+
+    switch x.opts.Existing {
+    case SkipExisting:
+        x.record(x.decision(hdr, target, ActionSkipped, "the file exists"))
+        return "", d, nil
+    case KeepNewer:
+        if !hdr.ModTime.After(mtime) {
+            x.record(x.decision(hdr, target, ActionSkipped, "the existing file is not older"))
+            return "", d, nil
+        }
+    case RenameNew:
+        dir, base := filepath.Split(target)
+        for n := 2; ; n++ {
+            p := filepath.Join(dir, numberedName(base, n))
+            if _, ok := x.planned[p]; ok {
+                continue
+            }
+            if _, err := os.Lstat(p); os.IsNotExist(err) {
+                d = x.decision(hdr, p, ActionRenamed, "the file exists")
+                x.record(d)
+                return p, d, nil
+            } else if err != nil {
+                return "", d, err
+            }
+        }
+    case FailExisting:
+        return "", d, &EntryError{Name: hdr.Name, Err: &os.PathError{Op: "extract", Path: target, Err: os.ErrExist}}
+    }
+    _, planned := x.planned[target]
+    if !planned && !(regular && isRegular(hdr)) {
+        if err := os.Remove(target); err != nil {
+            return "", d, err
+        }
+    }
+    d = x.decision(hdr, target, ActionOverwritten)
+    x.record(d)
+    return target, d, nil
+}
+
+func isRegular(hdr *tar.Header) bool {
+    return hdr.Typeflag == tar.TypeReg || hdr.Typeflag == tar.TypeRegA
+}
@@ -0,0 +1,256 @@
+package archive
+
+import (
+    "archive/tar"
+    "archive/zip"
+    "bytes"
+    "context"
+    "errors"
+    "io/ioutil"
+    "os"
+    "path/filepath"
+    "reflect"
+    "sort"
+    "testing"
+    "time"
+)
+
+var (
+    policyOld = time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC)
+    policyNew = time.Date(2030, 1, 1, 0, 0, 0, 0, time.UTC)
+)
+
+// policyTar returns a tar archive of files, all modified at mtime.
+func policyTar(t *testing.T, mtime time.Time, files map[string]string) []byte {
+    var names []string
+    for name := range files {
+        names = append(names, name)
+    }
+    sort.Strings(names)
+    var buf bytes.Buffer
+    tw := tar.NewWriter(&buf)
+    for _, name := range names {
+        hdr := &tar.Header{Name: name, Typeflag: tar.TypeReg, Mode: 0644, Size: int64(len(files[name])), ModTime: mtime}
+        if files[name] == "/" {
+            hdr.Typeflag, hdr.Mode, hdr.Size = tar.TypeDir, 0755, 0
+        }
+        if err := tw.WriteHeader(hdr); err != nil {
+            t.Fatal(err)
+        }
+        if hdr.Typeflag == tar.TypeReg {
+            tw.Write([]byte(files[name]))
+        }
+    }
+    if err := tw.Close(); err != nil {
+        t.Fatal(err)
+    }
+    return buf.Bytes()
+}
+
+// setupPolicyTree writes the existing files the policy tests extract onto:
+// old.txt is older than the archive, new.txt and a.txt newer.
+func setupPolicyTree(t *testing.T) string {
+    dst := tempDir(t)
+    writeTree(t, dst, map[string]string{"old.txt": "old on disk", "new.txt": "new on disk", "a.txt": "a on disk"})
+    os.Chtimes(filepath.Join(dst, "old.txt"), policyOld, policyOld)
+    os.Chtimes(filepath.Join(dst, "new.txt"), policyNew, policyNew)
+    return dst
+}
+
+func TestExtractExistingPolicy(t *testing.T) {
+    archive := policyTar(t, policyOld.AddDate(1, 0, 0), map[string]string{
+        "old.txt": "old from archive",
+        "new.txt": "new from archive",
+        "a.txt":   "a from archive",
+        "b.txt":   "b from archive",
+    })
+    for _, tc := range []struct {
+        policy ExistingPolicy
+        want   map[string]string
+    }{
+        {Overwrite, map[string]string{
+            "old.txt": "old from archive", "new.txt": "new from archive",
+            "a.txt": "a from archive", "b.txt": "b from archive",
+        }},
+        {SkipExisting, map[string]string{
+            "old.txt": "old on disk", "new.txt": "new on disk",
+            "a.txt": "a on disk", "b.txt": "b from archive",
+        }},
+        {KeepNewer, map[string]string{
+            "old.txt": "old from archive", "new.txt": "new on disk",
+            "a.txt": "a on disk", "b.txt": "b from archive",
+        }},
+        {RenameNew, map[string]string{
+            "old.txt": "old on disk", "old~2.txt": "old from archive",
+            "new.txt": "new on disk", "new~2.txt": "new from archive",
+            "a.txt": "a on disk", "a~2.txt": "a from archive", "b.txt": "b from archive",
+        }},
+    } {
+        dst := setupPolicyTree(t)
+        var actions []ExtractAction
+        opts := &ExtractOptions{Existing: tc.policy, Report: func(d ExtractDecision) { actions = append(actions, d.Action) }}
+        if err := ExtractTar(context.Background(), bytes.NewReader(archive), dst, opts); err != nil {
+            t.Fatalf("policy %d: %v", tc.policy, err)
+        }
+        checkTree(t, dst, tc.want)
+        if len(actions) != 4 {
+            t.Errorf("policy %d: decisions %v", tc.policy, actions)
+        }
+    }
+
+    dst := setupPolicyTree(t)
+    err := ExtractTar(context.Background(), bytes.NewReader(archive), dst, &ExtractOptions{Existing: FailExisting})
+    if !errors.Is(err, os.ErrExist) {
+        t.Errorf("FailExisting: %v", err)
+    }
+    checkTree(t, dst, map[string]string{"old.txt": "old on disk", "new.txt": "new on disk", "a.txt": "a on disk"})
+}
+
+func TestExtractExistingConcurrent(t *testing.T) {
+    // Duplicate names count as existing files in a concurrent extraction
+    // as they do in order.
+    var buf bytes.Buffer
+    zw := zip.NewWriter(&buf)
+    for _, body := range []string{"first", "second", "third"} {
+        fw, _ := zw.Create("dup.txt")
+        fw.Write([]byte(body))
+    }
+    zw.Close()
+    b := buf.Bytes()
+    for policy, want := range map[ExistingPolicy]map[string]string{
+        Overwrite:    {"dup.txt": "third"},
+        SkipExisting: {"dup.txt": "first"},
+        RenameNew:    {"dup.txt": "first", "dup~2.txt": "second", "dup~3.txt": "third"},
+    } {
+        for _, n := range []int{1, 4} {
+            dst := tempDir(t)
+            opts := &ExtractOptions{Existing: policy, Concurrency: n}
+            if err := ExtractZip(context.Background(), bytes.NewReader(b), int64(len(b)), dst, opts); err != nil {
+                t.Fatal(err)
+            }
+            checkTree(t, dst, want)
+        }
+    }
+}
+
+func TestExtractClashPolicy(t *testing.T) {
+    // The archive has a directory where the destination has a file, and
+    // a file where it has a directory.
+    archive := policyTar(t, policyOld, map[string]string{
+        "conf/":        "/",
+        "conf/app.ini": "ini",
+        "data":         "data file",
+    })
+    setup := func() string {
+        dst := tempDir(t)
+        writeTree(t, dst, map[string]string{"conf": "conf file", "data/x": "x"})
+        return dst
+    }
+
+    dst := setup()
+    err := ExtractTar(context.Background(), bytes.NewReader(archive), dst, nil)
+    if !errors.Is(err, ErrClash) {
+        t.Errorf("ClashError: %v", err)
+    }
+
+    dst = setup()
+    var got []ExtractDecision
+    opts := &ExtractOptions{Clash: ClashSkip, Report: func(d ExtractDecision) { got = append(got, d) }}
+    if err := ExtractTar(context.Background(), bytes.NewReader(archive), dst, opts); err != nil {
+        t.Fatal(err)
+    }
+    checkTree(t, dst, map[string]string{"conf": "conf file", "data/x": "x"})
+    want := []ExtractDecision{
+        {Name: "conf/", Path: filepath.Join(dst, "conf"), Action: ActionSkipped, UID: -1, GID: -1, Reason: "a file in the way"},
+        {Name: "conf/app.ini", Path: filepath.Join(dst, "conf"), Action: ActionSkipped, UID: -1, GID: -1, Reason: "a file in the way"},
+        {Name: "data", Path: filepath.Join(dst, "data"), Action: ActionSkipped, UID: -1, GID: -1, Reason: "a directory in the way"},
+    }
+    if !reflect.DeepEqual(got, want) {
+        t.Errorf("decisions:\n%+v\nwant\n%+v", got, want)
+    }
+
+    dst = setup()
+    if err := ExtractTar(context.Background(), bytes.NewReader(archive), dst, &ExtractOptions{Clash: ClashReplace}); err != nil {
+        t.Fatal(err)
+    }
+    checkTree(t, dst, map[string]string{"conf/app.ini": "ini", "data": "data file"})
+}
+
+func TestExtractModeAndOwner(t *testing.T) {
+    var buf bytes.Buffer
+    tw := tar.NewWriter(&buf)
+    uid, gid := os.Getuid(), os.Getgid()
+    for _, hdr := range []*tar.Header{
+        {Name: "bin/", Typeflag: tar.TypeDir, Mode: 02777, Uid: 1000, Gid: 1000},
+        {Name: "bin/tool", Typeflag: tar.TypeReg, Mode: 06755, Uid: 1000, Gid: 1000, Uname: "no-such-user-here", Gname: "no-such-group-here"},
+    } {
+        tw.WriteHeader(hdr)
+    }
+    tw.Close()
+
+    dst := tempDir(t)
+    var got []ExtractDecision
+    opts := &ExtractOptions{
+        Owner:      OwnerByName,
+        UIDMap:     map[int]int{1000: uid},
+        GIDMap:     map[int]int{1000: gid},
+        Umask:      022,
+        StripSetID: true,
+        Report:     func(d ExtractDecision) { got = append(got, d) },
+    }
+    if err := ExtractTar(context.Background(), &buf, dst, opts); err != nil {
+        t.Fatal(err)
+    }
+    for _, tc := range []struct {
+        name string
+        mode os.FileMode
+    }{
+        {"bin", os.ModeDir | 0755},
+        {"bin/tool", 0755},
+    } {
+        fi, err := os.Stat(filepath.Join(dst, tc.name))
+        if err != nil {
+            t.Fatal(err)
+        }
+        if fi.Mode() != tc.mode {
+            t.Errorf("%s mode = %v, want %v", tc.name, fi.Mode(), tc.mode)
+        }
+    }
+    if len(got) != 2 || got[1].UID != uid || got[1].GID != gid || got[1].Mode != 0755 ||
+        got[1].Reason != "setuid and setgid bits stripped; no user no-such-user-here, kept uid 1000; no group no-such-group-here, kept gid 1000" {
+        t.Errorf("decisions: %+v", got)
+    }
+    if b, _ := ioutil.ReadFile(filepath.Join(dst, "bin/tool")); len(b) != 0 {
+        t.Errorf("tool = %q", b)
+    }
+}
+
+func TestExtractOwnerUnknownNameCached(t *testing.T) {
+    // The second entry of an unknown user keeps its own ids too, once
+    // the name is known to be unknown.
+    var buf bytes.Buffer
+    tw := tar.NewWriter(&buf)
+    for _, name := range []string{"a", "b"} {
+        tw.WriteHeader(&tar.Header{Name: name, Typeflag: tar.TypeReg, Mode: 0644, Uid: 1000, Gid: 1000, Uname: "no-such-user-here", Gname: "no-such-group-here"})
+    }
+    tw.Close()
+    uid, gid := os.Getuid(), os.Getgid()
+    var got []ExtractDecision
+    opts := &ExtractOptions{
+        Owner:  OwnerByName,
+        UIDMap: map[int]int{1000: uid},
+        GIDMap: map[int]int{1000: gid},
+        Report: func(d ExtractDecision) { got = append(got, d) },
+    }
+    if err := ExtractTar(context.Background(), &buf, tempDir(t), opts); err != nil {
+        t.Fatal(err)
+    }
+    if len(got) != 2 {
+        t.Fatalf("decisions: %+v", got)
+    }
+    for _, d := range got {
+        if d.UID != uid || d.GID != gid || d.Reason != got[0].Reason {
+            t.Errorf("%s: uid %d, gid %d, %q", d.Name, d.UID, d.GID, d.Reason)
+        }
+    }
+}
@@ -0,0 +1,128 @@
+#!/usr/bin/env python3
+"""Generates the character set and Unicode normalization tables used by
+charset.go and normalize.go from Python's codecs and unicodedata modules,
+which the Go standard library has no counterpart for. It lives outside the
+package directory and writes the tables to the current directory, so it is
+run from the archive directory, as go generate does:
+
+    python3 gen/mktables.py
+"""
+import base64
+import struct
+import unicodedata
+import zlib
+
+
+def dbcs(codec, leads, trails):
+    """Returns the BMP code point of every two-byte sequence, or 0 where the
+    sequence is invalid or maps to the private use area."""
+    vals = []
+    for l in leads:
+        for t in trails:
+            try:
+                s = bytes([l, t]).decode(codec)
+            except UnicodeDecodeError:
+                s = ''
+            v = ord(s) if len(s) == 1 else 0
+            if v >= 0x10000 or 0xE000 <= v <= 0xF8FF:
+                v = 0
+            vals.append(v)
+    return vals
+
+
+def pack(vals):
+    """Delta-encodes vals as little-endian uint16s, compressed with raw
+    DEFLATE and base64-encoded."""
+    out = bytearray()
+    prev = 0
+    for v in vals:
+        out += struct.pack('<H', (v - prev) & 0xffff)
+        prev = v
+    c = zlib.compressobj(9, zlib.DEFLATED, -15)
+    return base64.b64encode(c.compress(bytes(out)) + c.flush()).decode()
+
+
+def go_string(name, s, width=96):
+    lines = [s[i:i + width] for i in range(0, len(s), width)]
+    return 'const %s = "" +\n' % name + ' +\n'.join('\t"%s"' % l for l in lines) + '\n'
+
+
+def esc(s):
+    out = ''
+    for ch in s:
+        cp = ord(ch)
+        if 0x21 <= cp < 0x7f and ch not in '"\\':
+            out += ch
+        elif cp < 0x10000:
+            out += '\\u%04x' % cp
+        else:
+            out += '\\U%08x' % cp
+    return out
+
+
+def go_runes(name, entries, per_line=8):
+    lines = [''.join(entries[i:i + per_line]) for i in range(0, len(entries), per_line)]
+    return 'const %s = "" +\n' % name + ' +\n'.join('\t"%s"' % l for l in lines) + '\n'
+
+
+def charsets():
+    src = ['// Code generated by mktables.py; DO NOT EDIT.\n', 'package archive\n']
+    specs = [
+        ('gbkData', 'gbk', 0x81, 0xfe, 0x40, 0xfe),
+        ('shiftJISData', 'cp932', 0x81, 0xfc, 0x40, 0xfc),
+        ('big5Data', 'cp950', 0x81, 0xfe, 0x40, 0xfe),
+    ]
+    for name, codec, llo, lhi, tlo, thi in specs:
+        vals = dbcs(codec, range(llo, lhi + 1), range(tlo, thi + 1))
+        src.append('// %s maps the two-byte sequences of %s to BMP code points.\n' % (name, codec) +
+                   go_string(name, pack(vals)))
+    with open('charset_tables.go', 'w') as f:
+        f.write('\n'.join(src))
+
+
+def normalization():
+    classes = []
+    for cp in range(0x110000):
+        c = unicodedata.combining(chr(cp))
+        if c and classes and classes[-1][1] == cp - 1 and classes[-1][2] == c:
+            classes[-1][1] = cp
+        elif c:
+            classes.append([cp, cp, c])
+    decomp, comp = [], []
+    for cp in range(0x110000):
+        if 0xAC00 <= cp <= 0xD7A3:
+            continue  # Hangul syllables are decomposed algorithmically.
+        ch = chr(cp)
+        d = unicodedata.normalize('NFD', ch)
+        if d != ch:
+            decomp.append(esc(ch + d) + ' ')
+        m = unicodedata.decomposition(ch)
+        if not m or m.startswith('<'):
+            continue
+        parts = [chr(int(x, 16)) for x in m.split()]
+        if len(parts) == 2 and unicodedata.normalize('NFC', ''.join(parts)) == ch:
+            comp.append(esc(ch + ''.join(parts)))
+
+    src = ['// Code generated by mktables.py; DO NOT EDIT.\n', 'package archive\n',
+           '// unicodeVersion is the Unicode version of the normalization tables.\n'
+           'const unicodeVersion = "%s"\n' % unicodedata.unidata_version]
+    rows = []
+    for i in range(0, len(classes), 4):
+        rows.append('\t' + ' '.join('{0x%04x, 0x%04x, %d},' % tuple(c) for c in classes[i:i + 4]))
+    src.append('// combiningClasses lists the code point ranges with a non-zero\n'
+               '// canonical combining class.\n'
+               'var combiningClasses = []struct {\n\tlo, hi rune\n\tclass uint8\n}{\n' +
+               '\n'.join(rows) + '\n}\n')
+    src.append('// nfcDecompositions holds, separated by spaces, each character with a\n'
+               '// canonical decomposition followed by its full decomposition.\n' +
+               go_runes('nfcDecompositions', decomp))
+    src.append('// nfcCompositions holds each primary composite followed by the two\n'
+               '// characters it is composed from.\n' +
+               go_runes('nfcCompositions', comp))
+    with open('nfc_tables.go', 'w') as f:
+        f.write('\n'.join(src))
+
+
+if __name__ == '__main__':
+    charsets()
+    normalization()
@@ -0,0 +1,285 @@
+package archive
+
+import (
+    "archive/zip"
+    "container/list"
+    "context"
+    "errors"
+    "fmt"
+    "io"
+    "net/http"
+    "strconv"
+    "strings"
+    "sync"
+)
+
+// ErrRemoteChanged is returned by HTTPReaderAt when the remote file no
+// longer matches the version whose size was recorded at open time.
+var ErrRemoteChanged = errors.New("archive: remote file changed")
+
+// HTTPOptions configures an HTTPReaderAt.
+type HTTPOptions struct {
+    Client      *http.Client // defaults to http.DefaultClient
+    BlockSize   int64        // cache block size, default 64 KiB
+    ReadAhead   int          // extra blocks fetched after a miss, default 2
+    CacheBlocks int          // blocks kept in the LRU cache, default 256
+}
+
+// HTTPReaderAt is an io.ReaderAt over a remote file, fetched on demand with
+// HTTP Range requests. Fetched data is cached in fixed-size blocks, and a
+// miss also fetches the following ReadAhead blocks, since archive readers
+// tend to read forward. It is safe for concurrent use.
+type HTTPReaderAt struct {
+    ctx    context.Context
+    client *http.Client
+    url    string
+    size   int64
+    etag   string
+    bs     int64
+    ahead  int
+    limit  int
+
+    mu      sync.Mutex
+    lru     *list.List // of *httpBlock, most recently used first
+    index   map[int64]*list.Element
+    pending map[int64]*httpFetch // blocks being fetched
+}
+
+type httpBlock struct {
+    n    int64
+    data []byte
+}
+
+// httpFetch is a range request in flight for the blocks from first on.
+// blocks and err are set before done is closed.
+type httpFetch struct {
+    first  int64
+    done   chan struct{}
+    blocks []*httpBlock
+    err    error
+}
+
+// NewHTTPReaderAt returns a reader for the file at url. It issues a HEAD
+// request to learn the size; ctx applies to every later request too.
+func NewHTTPReaderAt(ctx context.Context, url string, opts *HTTPOptions) (*HTTPReaderAt, error) {
+    if opts == nil {
+        opts = &HTTPOptions{}
+    }
+    r := &HTTPReaderAt{
+        ctx:     ctx,
+        client:  opts.Client,
+        url:     url,
+        bs:      opts.BlockSize,
+        ahead:   opts.ReadAhead,
+        limit:   opts.CacheBlocks,
+        lru:     list.New(),
+        index:   make(map[int64]*list.Element),
+        pending: make(map[int64]*httpFetch),
+    }
+    if r.client == nil {
+        r.client = http.DefaultClient
+    }
+    if r.bs <= 0 {
+        r.bs = 64 << 10
+    }
+    if r.ahead <= 0 {
+        r.ahead = 2
+    }
+    if r.limit <= 0 {
+        r.limit = 256
+    }
+    req, err := http.NewRequest(http.MethodHead, url, nil)
+    if err != nil {
+        return nil, err
+    }
+    resp, err := r.client.Do(req.WithContext(ctx))
+    if err != nil {
+        return nil, err
+    }
+    resp.Body.Close()
+    if resp.StatusCode != http.StatusOK {
+        return nil, fmt.Errorf("archive: HEAD %s: %s", url, resp.Status)
+    }
+    if resp.ContentLength < 0 {
+        return nil, fmt.Errorf("archive: HEAD %s: unknown content length", url)
+    }
+    if ar := resp.Header.Get("Accept-Ranges"); ar != "" && !strings.Contains(ar, "bytes") {
+        return nil, fmt.Errorf("archive: %s does not support range requests", url)
+    }
+    r.size = resp.ContentLength
+    r.etag = resp.Header.Get("ETag")
+    return r, nil
+}
+
+// OpenHTTPZip opens the remote zip archive at url. Only the central
+// directory and the entries that are actually read are downloaded.
+func OpenHTTPZip(ctx context.Context, url string, opts *HTTPOptions) (*zip.Reader, error) {
+    r, err := NewHTTPReaderAt(ctx, url, opts)
+    if err != nil {
+        return nil, err
+    }
+    return newZipReader(r, r.Size())
+}
+
+// Size returns the size of the remote file.
+func (r *HTTPReaderAt) Size() int64 { return r.size }
+
+// ReadAt implements io.ReaderAt.
+func (r *HTTPReaderAt) ReadAt(p []byte, off int64) (int, error) {
+    if off < 0 {
+        return 0, errors.New("archive: negative offset")
+    }
+    if off >= r.size {
+        return 0, io.EOF
+    }
+    end := off + int64(len(p))
+    if end > r.size {
+        end = r.size
+    }
+    n := 0
+    for pos := off; pos < end; {
+        b, err := r.block(pos/r.bs, (end-1)/r.bs)
+        if err != nil {
+            return n, err
+        }
+        c := copy(p[n:], b.data[pos-b.n*r.bs:])
+        n += c
+        pos += int64(c)
+    }
+    if n < len(p) {
+        return n, io.EOF
+    }
+    return n, nil
+}
+
+// block returns block i, fetching it together with the blocks up to last
+// and the read-ahead window when it is not cached. The lock is not held
+// while fetching; a read of a block that is being fetched waits for that
+// fetch only.
+func (r *HTTPReaderAt) block(i, last int64) (*httpBlock, error) {
+    r.mu.Lock()
+    if e, ok := r.index[i]; ok {
+        r.lru.MoveToFront(e)
+        r.mu.Unlock()
+        return e.Value.(*httpBlock), nil
+    }
+    if f, ok := r.pending[i]; ok {
+        r.mu.Unlock()
+        <-f.done
+        if f.err != nil {
+            return nil, f.err
+        }
+        return f.blocks[i-f.first], nil
+    }
+    hi := last + int64(r.ahead)
+    if max := (r.size - 1) / r.bs; hi > max {
+        hi = max
+    }
+    // Do not refetch blocks that are already cached or on their way.
+    for j := i + 1; j <= hi; j++ {
+        _, cached := r.index[j]
+        _, pending := r.pending[j]
+        if cached || pending {
+            hi = j - 1
+            break
+        }
+    }
+    f := &httpFetch{first: i, done: make(chan struct{})}
+    for j := i; j <= hi; j++ {
+        r.pending[j] = f
+    }
+    r.mu.Unlock()
+
+    data, err := r.fetch(i*r.bs, (hi+1)*r.bs)
+
+    r.mu.Lock()
+    defer r.mu.Unlock()
+    defer close(f.done)
+    for j := i; j <= hi; j++ {
+        delete(r.pending, j)
+    }
+    if err != nil {
+        f.err = err
+        return nil, err
+    }
+    for j := i; j <= hi; j++ {
+        lo := (j - i) * r.bs
+        up := lo + r.bs
+        if up > int64(len(data)) {
+            up = int64(len(data))
+        }
+        b := &httpBlock{n: j, data: data[lo:up:up]}
+        r.index[j] = r.lru.PushFront(b)
+        f.blocks = append(f.blocks, b)
+    }
+    for r.lru.Len() > r.limit {
+        e := r.lru.Back()
+        delete(r.index, e.Value.(*httpBlock).n)
+        r.lru.Remove(e)
+    }
+    // The requested block was pushed first, so it may have been evicted
+    // when the cache is smaller than one fetch; it is still returned.
+    return f.blocks[0], nil
+}
+
+// fetch downloads bytes [lo, hi) of the remote file.
+func (r *HTTPReaderAt) fetch(lo, hi int64) ([]byte, error) {
+    if hi > r.size {
+        hi = r.size
+    }
+    req, err := http.NewRequest(http.MethodGet, r.url, nil)
+    if err != nil {
+        return nil, err
+    }
+    req.Header.Set("Range", fmt.Sprintf("bytes=%d-%d", lo, hi-1))
+    // If-Range takes a strong validator only. Without one a change is
+    // caught by the total length in Content-Range alone.
+    if r.etag != "" && !strings.HasPrefix(r.etag, "W/") {
+        req.Header.Set("If-Range", r.etag)
+    }
+    resp, err := r.client.Do(req.WithContext(r.ctx))
+    if err != nil {
+        return nil, err
+    }
+    defer resp.Body.Close()
+    switch resp.StatusCode {
+    case http.StatusPartialContent:
+    case http.StatusOK:
+        // The server ignored the range, or If-Range failed.
+        return nil, ErrRemoteChanged
+    default:
+        return nil, fmt.Errorf("archive: GET %s: %s", r.url, resp.Status)
+    }
+    if start, total, ok := parseContentRange(resp.Header.Get("Content-Range")); !ok || start != lo || (total >= 0 && total != r.size) {
+        return nil, ErrRemoteChanged
+    }
+    data := make([]byte, hi-lo)
+    if _, err := io.ReadFull(resp.Body, data); err != nil {
+        return nil, err
+    }
+    return data, nil
+}
+
+// parseContentRange parses "bytes start-end/total". total is -1 for "*".
+func parseContentRange(s string) (start, total int64, ok bool) {
+    if !strings.HasPrefix(s, "bytes ") {
+        return 0, 0, false
+    }
+    s = s[len("bytes "):]
+    slash := strings.IndexByte(s, '/')
+    dash := strings.IndexByte(s, '-')
+    if slash < 0 || dash < 0 || dash > slash {
+        return 0, 0, false
+    }
+    start, err := strconv.ParseInt(s[:dash], 10, 64)
+    if err != nil {
+        return 0, 0, false
+    }
+    total = -1
+    if t := s[slash+1:]; t != "*" {
+        if total, err = strconv.ParseInt(t, 10, 64); err != nil {
+            return 0, 0, false
+        }
+    }
+    return start, total, true
+}
@@ -0,0 +1,173 @@
+package archive
+
+import (
+    "archive/zip"
+    "bytes"
+    "context"
+    "io/ioutil"
+    "math/rand"
+    "net/http"
+    "net/http/httptest"
+    "strings"
+    "sync"
+    "sync/atomic"
+    "testing"
+    "time"
+)
+
+// countingServer serves data with Range support and counts the body bytes
+// it sends.
+func countingServer(data []byte, served *int64) *httptest.Server {
+    return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+        w.Header().Set("ETag", `"v1"`)
+        http.ServeContent(&countingResponse{w, served}, r, "bundle.zip", time.Time{}, bytes.NewReader(data))
+    }))
+}
+
+type countingResponse struct {
+    http.ResponseWriter
+    n *int64
+}
+
+func (w *countingResponse) Write(p []byte) (int, error) {
+    n, err := w.ResponseWriter.Write(p)
+    atomic.AddInt64(w.n, int64(n))
+    return n, err
+}
+
+func TestHTTPZipFetchesOnlyNeededBytes(t *testing.T) {
+    big := make([]byte, 4<<20)
+    rand.New(rand.NewSource(1)).Read(big)
+    var buf bytes.Buffer
+    zw := zip.NewWriter(&buf)
+    w, _ := zw.CreateHeader(&zip.FileHeader{Name: "big.bin", Method: zip.Store})
+    w.Write(big)
+    w, _ = zw.Create("small.txt")
+    w.Write([]byte("hello from the end of the archive"))
+    if err := zw.Close(); err != nil {
+        t.Fatal(err)
+    }
+
+    var served int64
+    srv := countingServer(buf.Bytes(), &served)
+    defer srv.Close()
+
+    zr, err := OpenHTTPZip(context.Background(), srv.URL, &HTTPOptions{BlockSize: 16 << 10})
+    if err != nil {
+        t.Fatal(err)
+    }
+    for _, f := range zr.File {
+        if f.Name != "small.txt" {
+            continue
+        }
+        rc, err := f.Open()
+        if err != nil {
+            t.Fatal(err)
+        }
+        b, err := ioutil.ReadAll(rc)
+        rc.Close()
+        if err != nil || string(b) != "hello from the end of the archive" {
+            t.Fatalf("small.txt = %q, %v", b, err)
+        }
+    }
+    if n := atomic.LoadInt64(&served); n > int64(buf.Len())/20 {
+        t.Fatalf("served %d of %d bytes", n, buf.Len())
+    }
+}
+
+func TestHTTPReaderAtRandomReads(t *testing.T) {
+    data := make([]byte, 300000)
+    rand.New(rand.NewSource(2)).Read(data)
+    var served int64
+    srv := countingServer(data, &served)
+    defer srv.Close()
+
+    r, err := NewHTTPReaderAt(context.Background(), srv.URL, &HTTPOptions{BlockSize: 1000, CacheBlocks: 8})
+    if err != nil {
+        t.Fatal(err)
+    }
+    if r.Size() != int64(len(data)) {
+        t.Fatalf("Size = %d", r.Size())
+    }
+    rnd := rand.New(rand.NewSource(3))
+    for i := 0; i < 200; i++ {
+        off := rnd.Int63n(int64(len(data)))
+        p := make([]byte, rnd.Intn(5000))
+        n, err := r.ReadAt(p, off)
+        want := data[off:]
+        if len(want) > len(p) {
+            want = want[:len(p)]
+        }
+        if n != len(want) || !bytes.Equal(p[:n], want) {
+            t.Fatalf("ReadAt(%d, %d) = %d, %v", len(p), off, n, err)
+        }
+        if n < len(p) && err == nil {
+            t.Fatalf("short ReadAt without error")
+        }
+    }
+}
+
+func TestHTTPReaderAtConcurrentBlocks(t *testing.T) {
+    // A slow fetch holds up reads of its own blocks only.
+    data := make([]byte, 100000)
+    rand.New(rand.NewSource(4)).Read(data)
+    release := make(chan struct{})
+    var requests int64
+    srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+        if r.Method == http.MethodGet {
+            atomic.AddInt64(&requests, 1)
+            if strings.HasPrefix(r.Header.Get("Range"), "bytes=0-") {
+                <-release
+            }
+        }
+        http.ServeContent(w, r, "data", time.Time{}, bytes.NewReader(data))
+    }))
+    defer srv.Close()
+
+    r, err := NewHTTPReaderAt(context.Background(), srv.URL, &HTTPOptions{BlockSize: 1000, ReadAhead: 1})
+    if err != nil {
+        t.Fatal(err)
+    }
+    var wg sync.WaitGroup
+    for i := 0; i < 4; i++ {
+        wg.Add(1)
+        go func() {
+            defer wg.Done()
+            p := make([]byte, 10)
+            if _, err := r.ReadAt(p, 5); err != nil || !bytes.Equal(p, data[5:15]) {
+                t.Errorf("ReadAt(10, 5) = %v", err)
+            }
+        }()
+    }
+    // Wait for the slow fetch to start.
+    for atomic.LoadInt64(&requests) == 0 {
+        time.Sleep(time.Millisecond)
+    }
+    p := make([]byte, 10)
+    if _, err := r.ReadAt(p, 50000); err != nil || !bytes.Equal(p, data[50000:50010]) {
+        t.Fatalf("ReadAt(10, 50000) = %v", err)
+    }
+    close(release)
+    wg.Wait()
+    if n := atomic.LoadInt64(&requests); n != 2 {
+        t.Errorf("%d range requests, want 2", n)
+    }
+}
+
+func TestHTTPReaderAtWeakETag(t *testing.T) {
+    // A weak ETag is not sent as If-Range, which takes strong ones only.
+    data := []byte("some remote data served with a weak validator")
+    srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+        w.Header().Set("ETag", `W/"v1"`)
+        http.ServeContent(w, r, "data", time.Time{}, bytes.NewReader(data))
+    }))
+    defer srv.Close()
+    r, err := NewHTTPReaderAt(context.Background(), srv.URL, &HTTPOptions{BlockSize: 8})
+    if err != nil {
+        t.Fatal(err)
+    }
+    p := make([]byte, 6)
+    if _, err := r.ReadAt(p, 5); err != nil || string(p) != "remote" {
+        t.Fatalf("ReadAt = %q, %v", p, err)
+    }
+}
@@ -0,0 +1,447 @@
+package archive
+
+import (
+    "archive/tar"
+    "archive/zip"
+    "encoding/binary"
+    "fmt"
+    "hash/adler32"
+    "html"
+    "io"
+    "io/ioutil"
+    "mime"
+    "net/http"
+    "net/url"
+    "path"
+    "sort"
+    "strings"
+    "sync"
+    "time"
+)
+
+// serveEntry is one member of an archive served by Handler.
+type serveEntry struct {
+    name     string // clean slash separated name, "" for the root
+    dir      bool
+    size     int64
+    mtime    time.Time
+    linkname string
+
+    zf     *zip.File // zip member
+    offset int64     // data offset of a contiguous tar member, or -1
+
+    adlerOnce sync.Once // computes adler for a deflated zip member
+    adler     uint32
+    adlerErr  error
+}
+
+// Handler serves the members of a tar or zip archive over HTTP without
+// extracting it. Directories are rendered as HTML listings. Member
+// responses carry Content-Type, ETag and Last-Modified derived from the
+// entry metadata and support Range requests. Stored zip members are sent
+// straight from the archive; deflated members are sent as is, wrapped in
+// the zlib format that Content-Encoding: deflate calls for, when the client
+// accepts it. The wrapper ends with an Adler-32 checksum of the content,
+// which is worked out the first time the member is sent that way.
+type Handler struct {
+    ra       io.ReaderAt
+    entries  map[string]*serveEntry
+    children map[string][]string
+}
+
+// NewHandler indexes the archive in r, which is size bytes long.
+func NewHandler(r io.ReaderAt, size int64) (*Handler, error) {
+    h := &Handler{
+        ra:       r,
+        entries:  map[string]*serveEntry{"": {dir: true}},
+        children: make(map[string][]string),
+    }
+    var err error
+    switch DetectFormat(r) {
+    case FormatZip:
+        err = h.indexZip(size)
+    case FormatTar:
+        err = h.indexTar(size)
+    default:
+        err = ErrFormat
+    }
+    if err != nil {
+        return nil, err
+    }
+    for _, c := range h.children {
+        sort.Strings(c)
+    }
+    return h, nil
+}
+
+func (h *Handler) indexZip(size int64) error {
+    zr, err := newZipReader(h.ra, size)
+    if err != nil {
+        return err
+    }
+    for _, f := range zr.File {
+        hdr := zipHeader(&f.FileHeader)
+        e := &serveEntry{size: hdr.Size, mtime: hdr.ModTime, zf: f, offset: -1}
+        if hdr.Typeflag == tar.TypeSymlink {
+            rc, err := f.Open()
+            if err != nil {
+                continue
+            }
+            b := make([]byte, 1024)
+            n, _ := io.ReadFull(rc, b)
+            rc.Close()
+            e.linkname = string(b[:n])
+        }
+        h.add(f.Name, hdr.Typeflag == tar.TypeDir, e)
+    }
+    return nil
+}
+
+func (h *Handler) indexTar(size int64) error {
+    sr := io.NewSectionReader(h.ra, 0, size)
+    tr := tar.NewReader(sr)
+    for {
+        hdr, err := tr.Next()
+        if err == io.EOF {
+            return nil
+        }
+        if err != nil {
+            return err
+        }
+        // tar.Reader seeks past member data, so the section reader is
+        // positioned at the start of the data after Next.
+        off, _ := sr.Seek(0, io.SeekCurrent)
+        e := &serveEntry{size: hdr.Size, mtime: hdr.ModTime, linkname: hdr.Linkname, offset: off}
+        switch hdr.Typeflag {
+        case tar.TypeReg, tar.TypeRegA:
+        case tar.TypeDir:
+        case tar.TypeSymlink:
+            e.offset = -1
+        case tar.TypeLink:
+            // Hard link targets are archive paths, not relative ones.
+            e.offset = -1
+            e.linkname = "/" + hdr.Linkname
+        default:
+            continue
+        }
+        h.add(hdr.Name, hdr.Typeflag == tar.TypeDir, e)
+    }
+}
+
+// add records e under name, creating implicit parent directories.
+func (h *Handler) add(name string, dir bool, e *serveEntry) {
+    clean, err := cleanName(name)
+    if err != nil || clean == "." {
+        return
+    }
+    e.name, e.dir = clean, dir
+    if _, ok := h.entries[clean]; ok {
+        // A later member replaces an earlier one, as on extraction.
+        h.entries[clean] = e
+        return
+    }
+    h.entries[clean] = e
+    for {
+        parent := path.Dir(clean)
+        if parent == "." {
+            parent = ""
+        }
+        h.children[parent] = append(h.children[parent], path.Base(clean))
+        if _, ok := h.entries[parent]; ok {
+            return
+        }
+        h.entries[parent] = &serveEntry{name: parent, dir: true, offset: -1}
+        clean = parent
+    }
+}
+
+func (h *Handler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+    if r.Method != http.MethodGet && r.Method != http.MethodHead {
+        w.Header().Set("Allow", "GET, HEAD")
+        http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+        return
+    }
+    name := strings.Trim(path.Clean("/"+r.URL.Path), "/")
+    e, ok := h.entries[name]
+    if !ok {
+        http.NotFound(w, r)
+        return
+    }
+    if e.dir {
+        if !strings.HasSuffix(r.URL.Path, "/") {
+            redirect(w, path.Base(r.URL.Path)+"/", http.StatusMovedPermanently)
+            return
+        }
+        h.serveDir(w, r, e)
+        return
+    }
+    if e.linkname != "" {
+        dir := path.Dir("/" + name)
+        target := e.linkname
+        if !path.IsAbs(target) {
+            target = path.Join(dir, target)
+        }
+        redirect(w, (&url.URL{Path: relPath(dir, target)}).String(), http.StatusFound)
+        return
+    }
+    h.serveFile(w, r, e)
+}
+
+// redirect redirects to the relative URL rel. Unlike http.Redirect it
+// leaves rel relative, to be resolved by the client against the URL it
+// asked for, so that it works under http.StripPrefix.
+func redirect(w http.ResponseWriter, rel string, code int) {
+    w.Header().Set("Location", rel)
+    w.WriteHeader(code)
+}
+
+// relPath returns the slash separated path target relative to the
+// directory dir, both rooted at the archive root.
+func relPath(dir, target string) string {
+    split := func(p string) []string {
+        if p = strings.Trim(p, "/"); p == "" {
+            return nil
+        }
+        return strings.Split(p, "/")
+    }
+    d, t := split(dir), split(path.Clean(target))
+    i := 0
+    for i < len(d) && i < len(t) && d[i] == t[i] {
+        i++
+    }
+    var parts []string
+    for range d[i:] {
+        parts = append(parts, "..")
+    }
+    parts = append(parts, t[i:]...)
+    if len(parts) == 0 || parts[0] != ".." {
+        // Keep a first segment with a colon from reading as a scheme.
+        parts = append([]string{"."}, parts...)
+    }
+    return strings.Join(parts, "/")
+}
+
+func (h *Handler) serveDir(w http.ResponseWriter, r *http.Request, e *serveEntry) {
+    w.Header().Set("Content-Type", "text/html; charset=utf-8")
+    if !e.mtime.IsZero() {
+        w.Header().Set("Last-Modified", e.mtime.UTC().Format(http.TimeFormat))
+    }
+    if r.Method == http.MethodHead {
+        return
+    }
+    fmt.Fprintf(w, "<pre>\n")
+    for _, child := range h.children[e.name] {
+        c := h.entries[path.Join(e.name, child)]
+        if c.dir {
+            child += "/"
+        }
+        u := url.URL{Path: child}
+        fmt.Fprintf(w, "<a href=\"%s\">%s</a>\n", u.String(), html.EscapeString(child))
+    }
+    fmt.Fprintf(w, "</pre>\n")
+}
+
+func (h *Handler) serveFile(w http.ResponseWriter, r *http.Request, e *serveEntry) {
+    ctype := mime.TypeByExtension(path.Ext(e.name))
+    etag := fmt.Sprintf(`"%x-%x"`, e.mtime.UnixNano(), e.size)
+    var content io.ReadSeeker
+    switch {
+    case e.zf == nil && e.offset >= 0:
+        content = io.NewSectionReader(h.ra, e.offset, e.size)
+    case e.zf != nil && e.zf.Method == zip.Store:
+        off, err := e.zf.DataOffset()
+        if err != nil {
+            http.Error(w, err.Error(), http.StatusInternalServerError)
+            return
+        }
+        content = io.NewSectionReader(h.ra, off, e.size)
+        etag = fmt.Sprintf(`"%08x-%x"`, e.zf.CRC32, e.size)
+    case e.zf != nil:
+        etag = fmt.Sprintf(`"%08x-%x"`, e.zf.CRC32, e.size)
+        if ctype == "" {
+            ctype = sniffZip(e.zf)
+        }
+        w.Header().Add("Vary", "Accept-Encoding")
+        if e.zf.Method == zip.Deflate && acceptsDeflate(r) {
+            z, err := h.zlibMember(e)
+            if err != nil {
+                http.Error(w, err.Error(), http.StatusInternalServerError)
+                return
+            }
+            w.Header().Set("Content-Encoding", "deflate")
+            etag = etag[:len(etag)-1] + `-deflate"`
+            content = io.NewSectionReader(z, 0, z.n+6)
+        } else {
+            zs := &zipSeeker{f: e.zf, size: e.size}
+            defer zs.Close()
+            content = zs
+        }
+    default:
+        http.NotFound(w, r)
+        return
+    }
+    if ctype != "" {
+        w.Header().Set("Content-Type", ctype)
+    }
+    w.Header().Set("ETag", etag)
+    http.ServeContent(w, r, e.name, e.mtime, content)
+}
+
+// zlibMember returns the deflated member e in a zlib wrapper.
+func (h *Handler) zlibMember(e *serveEntry) (*zlibMember, error) {
+    off, err := e.zf.DataOffset()
+    if err != nil {
+        return nil, err
+    }
+    e.adlerOnce.Do(func() {
+        var rc io.ReadCloser
+        if rc, e.adlerErr = e.zf.Open(); e.adlerErr != nil {
+            return
+        }
+        defer rc.Close()
+        sum := adler32.New()
+        if _, e.adlerErr = copyBuffer(sum, rc); e.adlerErr == nil {
+            e.adler = sum.Sum32()
+        }
+    })
+    if e.adlerErr != nil {
+        return nil, e.adlerErr
+    }
+    return &zlibMember{ra: h.ra, off: off, n: int64(e.zf.CompressedSize64), adler: e.adler}, nil
+}
+
+// zlibMember is a raw deflate stream of n bytes at off in ra, read with a
+// zlib header before it and the Adler-32 checksum of its content after.
+type zlibMember struct {
+    ra    io.ReaderAt
+    off   int64
+    n     int64
+    adler uint32
+}
+
+func (z *zlibMember) ReadAt(p []byte, off int64) (int, error) {
+    head := []byte{0x78, 0x9c} // deflate, 32K window, default level
+    tail := make([]byte, 4)
+    binary.BigEndian.PutUint32(tail, z.adler)
+    n := 0
+    for n < len(p) && off < z.n+6 {
+        var c int
+        switch {
+        case off < 2:
+            c = copy(p[n:], head[off:])
+        case off < 2+z.n:
+            q := p[n:]
+            if rest := 2 + z.n - off; int64(len(q)) > rest {
+                q = q[:rest]
+            }
+            var err error
+            if c, err = z.ra.ReadAt(q, z.off+off-2); c < len(q) {
+                if err == nil || err == io.EOF {
+                    err = io.ErrUnexpectedEOF
+                }
+                return n + c, err
+            }
+        default:
+            c = copy(p[n:], tail[off-2-z.n:])
+        }
+        n += c
+        off += int64(c)
+    }
+    if n < len(p) {
+        return n, io.EOF
+    }
+    return n, nil
+}
+
+// acceptsDeflate reports whether the request allows a deflate coding.
+func acceptsDeflate(r *http.Request) bool {
+    for _, v := range r.Header["Accept-Encoding"] {
+        for _, coding := range strings.Split(v, ",") {
+            coding = strings.TrimSpace(coding)
+            name := coding
+            if i := strings.IndexByte(coding, ';'); i >= 0 {
+                name = strings.TrimSpace(coding[:i])
+                if q := strings.TrimSpace(coding[i+1:]); q == "q=0" || q == "q=0.0" || q == "q=0.00" || q == "q=0.000" {
+                    continue
+                }
+            }
+            if strings.EqualFold(name, "deflate") {
+                return true
+            }
+        }
+    }
+    return false
+}
+
+// sniffZip guesses the content type of a compressed zip member.
+func sniffZip(f *zip.File) string {
+    rc, err := f.Open()
+    if err != nil {
+        return ""
+    }
+    defer rc.Close()
+    b := make([]byte, 512)
+    n, _ := io.ReadFull(rc, b)
+    return http.DetectContentType(b[:n])
+}
+
+// zipSeeker gives a compressed zip member the io.ReadSeeker interface that
+// http.ServeContent needs. Seeking backwards reopens the member.
+type zipSeeker struct {
+    f    *zip.File
+    size int64
+    rc   io.ReadCloser
+    rpos int64 // position of rc
+    pos  int64 // position requested by Seek
+}
+
+func (z *zipSeeker) Seek(offset int64, whence int) (int64, error) {
+    switch whence {
+    case io.SeekStart:
+    case io.SeekCurrent:
+        offset += z.pos
+    case io.SeekEnd:
+        offset += z.size
+    default:
+        return 0, fmt.Errorf("archive: invalid whence %d", whence)
+    }
+    if offset < 0 {
+        return 0, fmt.Errorf("archive: negative seek position")
+    }
+    z.pos = offset
+    return offset, nil
+}
+
+// Close closes the member if it is open.
+func (z *zipSeeker) Close() error {
+    if z.rc == nil {
+        return nil
+    }
+    err := z.rc.Close()
+    z.rc = nil
+    return err
+}
+
+func (z *zipSeeker) Read(p []byte) (int, error) {
+    if z.rc == nil || z.pos < z.rpos {
+        if z.rc != nil {
+            z.rc.Close()
+        }
+        rc, err := z.f.Open()
+        if err != nil {
+            return 0, err
+        }
+        z.rc, z.rpos = rc, 0
+    }
+    if z.pos > z.rpos {
+        n, err := io.CopyN(ioutil.Discard, z.rc, z.pos-z.rpos)
+        z.rpos += n
+        if err != nil {
+            return 0, err
+        }
+    }
+    n, err := z.rc.Read(p)
+    z.rpos += int64(n)
+    z.pos = z.rpos
+    return n, err
+}
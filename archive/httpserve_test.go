@@ -0,0 +1,162 @@
+package archive
+
+import (
+    "archive/tar"
+    "archive/zip"
+    "bytes"
+    "compress/zlib"
+    "io/ioutil"
+    "net/http"
+    "net/http/httptest"
+    "net/url"
+    "strings"
+    "testing"
+    "time"
+)
+
+var serveBody = strings.Repeat("Gopher names:\nGeorge\nGeoffrey\nGonzo\n", 100)
+
+func serveZip(t *testing.T) *Handler {
+    var buf bytes.Buffer
+    zw := zip.NewWriter(&buf)
+    mtime := time.Date(2020, 5, 1, 12, 0, 0, 0, time.UTC)
+    for _, f := range []struct {
+        name   string
+        method uint16
+    }{{"docs/stored.txt", zip.Store}, {"docs/deflated.txt", zip.Deflate}, {"data", zip.Deflate}} {
+        w, err := zw.CreateHeader(&zip.FileHeader{Name: f.name, Method: f.method, Modified: mtime})
+        if err != nil {
+            t.Fatal(err)
+        }
+        w.Write([]byte(serveBody))
+    }
+    if err := zw.Close(); err != nil {
+        t.Fatal(err)
+    }
+    h, err := NewHandler(bytes.NewReader(buf.Bytes()), int64(buf.Len()))
+    if err != nil {
+        t.Fatal(err)
+    }
+    return h
+}
+
+func get(h http.Handler, target string, header ...string) *httptest.ResponseRecorder {
+    req := httptest.NewRequest("GET", target, nil)
+    for i := 0; i+1 < len(header); i += 2 {
+        req.Header.Set(header[i], header[i+1])
+    }
+    rec := httptest.NewRecorder()
+    h.ServeHTTP(rec, req)
+    return rec
+}
+
+func TestHandlerListing(t *testing.T) {
+    h := serveZip(t)
+    rec := get(h, "/")
+    if rec.Code != 200 || !strings.Contains(rec.Body.String(), `href="docs/"`) || !strings.Contains(rec.Body.String(), `href="data"`) {
+        t.Fatalf("root listing: %d %s", rec.Code, rec.Body)
+    }
+    if rec := get(h, "/docs"); rec.Code != http.StatusMovedPermanently {
+        t.Fatalf("/docs: %d", rec.Code)
+    }
+    if rec := get(h, "/missing"); rec.Code != http.StatusNotFound {
+        t.Fatalf("/missing: %d", rec.Code)
+    }
+}
+
+func TestHandlerStored(t *testing.T) {
+    h := serveZip(t)
+    rec := get(h, "/docs/stored.txt")
+    if rec.Code != 200 || rec.Body.String() != serveBody {
+        t.Fatalf("GET: %d", rec.Code)
+    }
+    if ct := rec.Header().Get("Content-Type"); !strings.HasPrefix(ct, "text/plain") {
+        t.Errorf("Content-Type = %q", ct)
+    }
+    if lm := rec.Header().Get("Last-Modified"); lm != "Fri, 01 May 2020 12:00:00 GMT" {
+        t.Errorf("Last-Modified = %q", lm)
+    }
+    etag := rec.Header().Get("ETag")
+    if rec := get(h, "/docs/stored.txt", "If-None-Match", etag); rec.Code != http.StatusNotModified {
+        t.Errorf("If-None-Match: %d", rec.Code)
+    }
+    rec = get(h, "/docs/stored.txt", "Range", "bytes=14-19")
+    if rec.Code != http.StatusPartialContent || rec.Body.String() != "George" {
+        t.Errorf("Range: %d %q", rec.Code, rec.Body)
+    }
+}
+
+func TestHandlerDeflated(t *testing.T) {
+    h := serveZip(t)
+    rec := get(h, "/docs/deflated.txt", "Accept-Encoding", "gzip, deflate")
+    if rec.Header().Get("Content-Encoding") != "deflate" {
+        t.Fatalf("Content-Encoding = %q", rec.Header().Get("Content-Encoding"))
+    }
+    if rec.Body.Len() >= len(serveBody) {
+        t.Fatalf("body was not sent compressed")
+    }
+    // The body is zlib data, whose checksum the reader verifies.
+    zr, err := zlib.NewReader(rec.Body)
+    if err != nil {
+        t.Fatal(err)
+    }
+    b, err := ioutil.ReadAll(zr)
+    if err != nil || string(b) != serveBody {
+        t.Fatalf("inflated body mismatch: %v", err)
+    }
+    rec = get(h, "/docs/deflated.txt", "Accept-Encoding", "deflate", "Range", "bytes=0-1")
+    if rec.Code != http.StatusPartialContent || rec.Body.String() != "\x78\x9c" {
+        t.Fatalf("Range of the zlib stream: %d %q", rec.Code, rec.Body)
+    }
+
+    rec = get(h, "/docs/deflated.txt", "Range", "bytes=14-19")
+    if rec.Code != http.StatusPartialContent || rec.Body.String() != "George" || rec.Header().Get("Content-Encoding") != "" {
+        t.Fatalf("Range: %d %q", rec.Code, rec.Body)
+    }
+    // Without an extension the type is sniffed from the content.
+    rec = get(h, "/data")
+    if ct := rec.Header().Get("Content-Type"); !strings.HasPrefix(ct, "text/plain") || rec.Body.String() != serveBody {
+        t.Fatalf("Content-Type = %q", ct)
+    }
+}
+
+func TestHandlerTar(t *testing.T) {
+    var buf bytes.Buffer
+    tw := tar.NewWriter(&buf)
+    tw.WriteHeader(&tar.Header{Name: "logs/", Typeflag: tar.TypeDir, Mode: 0755})
+    tw.WriteHeader(&tar.Header{Name: "logs/app.log", Mode: 0644, Size: int64(len(serveBody)), ModTime: time.Unix(1e9, 0)})
+    tw.Write([]byte(serveBody))
+    tw.WriteHeader(&tar.Header{Name: "latest", Typeflag: tar.TypeSymlink, Linkname: "logs/app.log"})
+    tw.WriteHeader(&tar.Header{Name: "logs/up", Typeflag: tar.TypeSymlink, Linkname: "../latest"})
+    tw.Close()
+    h, err := NewHandler(bytes.NewReader(buf.Bytes()), int64(buf.Len()))
+    if err != nil {
+        t.Fatal(err)
+    }
+    if rec := get(h, "/logs/app.log", "Range", "bytes=14-19"); rec.Body.String() != "George" {
+        t.Fatalf("Range: %d %q", rec.Code, rec.Body)
+    }
+    if rec := get(h, "/latest"); rec.Code != http.StatusFound || rec.Header().Get("Location") != "./logs/app.log" {
+        t.Fatalf("symlink: %d %q", rec.Code, rec.Header().Get("Location"))
+    }
+
+    // Redirects are relative, so they work with the handler mounted
+    // under a prefix.
+    mux := http.NewServeMux()
+    mux.Handle("/files/", http.StripPrefix("/files", h))
+    for _, tc := range []struct{ target, want string }{
+        {"/files/latest", "/files/logs/app.log"},
+        {"/files/logs", "/files/logs/"},
+        {"/files/logs/up", "/files/latest"},
+    } {
+        rec := get(mux, tc.target)
+        loc, err := url.Parse(rec.Header().Get("Location"))
+        if err != nil || rec.Code/100 != 3 {
+            t.Fatalf("%s: %d %v", tc.target, rec.Code, err)
+        }
+        base, _ := url.Parse("http://example.com" + tc.target)
+        if got := base.ResolveReference(loc).Path; got != tc.want {
+            t.Errorf("%s redirects to %s, want %s", tc.target, got, tc.want)
+        }
+    }
+}
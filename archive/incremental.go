@@ -0,0 +1,450 @@
+package archive
+
+import (
+    "archive/tar"
+    "bufio"
+    "bytes"
+    "compress/gzip"
+    "context"
+    "errors"
+    "fmt"
+    "io"
+    "io/ioutil"
+    "os"
+    "path"
+    "path/filepath"
+    "strconv"
+    "strings"
+    "time"
+)
+
+// Incremental backups follow GNU tar's --listed-incremental scheme. A
+// snapshot file records, for every directory, its device, inode, mtime and
+// the names it held. Each dump writes one 'D' member per directory whose
+// content, the dumpdir, lists the directory's entries as it was at dump
+// time, followed by the files that changed since the previous snapshot.
+// Restoring a dump deletes whatever is on disk but missing from a dumpdir,
+// so applying the full dump and then each incremental one in order
+// reproduces the final tree, deletions included.
+//
+// Member and snapshot names are those GNU tar uses for
+//
+//	tar --listed-incremental=snapshot -C src -c .
+//
+// so the two can continue each other's dump chains.
+
+// TypeGNUDumpdir is the tar typeflag of a directory member whose content
+// is a dumpdir.
+const TypeGNUDumpdir = 'D'
+
+// Dumpdir entry codes.
+const (
+    dumpdirIncluded  = 'Y' // file is in this archive
+    dumpdirUnchanged = 'N' // file is only in an earlier archive
+    dumpdirDir       = 'D' // subdirectory, which has its own member
+)
+
+// snapshotMagic starts a version 2 snapshot file; GNU tar accepts any
+// version string between the package name and the format number.
+const snapshotMagic = "GNU tar-1.35-2\n"
+
+// snapshotSlack is how much earlier than the start of a dump the
+// snapshot time is recorded.
+const snapshotSlack = 20 * time.Millisecond
+
+// ErrSnapshotFormat is returned for snapshot files that are not in GNU
+// tar's version 2 format.
+var ErrSnapshotFormat = errors.New("archive: not a version 2 incremental snapshot")
+
+// snapshot is the content of a snapshot file.
+type snapshot struct {
+    time time.Time // when the dump that wrote it began
+    dirs map[string]*snapshotDir
+    // order is the directory names in the order written.
+    order []string
+}
+
+// snapshotDir is the record of one directory.
+type snapshotDir struct {
+    nfs      bool
+    mtime    time.Time
+    dev, ino uint64
+    dumpdir  []string // code byte followed by name
+}
+
+// readSnapshot reads the snapshot file name, returning nil if it does not
+// exist.
+func readSnapshot(name string) (*snapshot, error) {
+    data, err := ioutil.ReadFile(name)
+    if os.IsNotExist(err) {
+        return nil, nil
+    }
+    if err != nil {
+        return nil, err
+    }
+    if len(data) == 0 {
+        // An empty file, such as a fresh temporary, starts a new chain.
+        return nil, nil
+    }
+    nl := bytes.IndexByte(data, '\n')
+    if nl < 0 || !strings.HasPrefix(string(data[:nl]), "GNU tar-") || !strings.HasSuffix(string(data[:nl]), "-2") {
+        return nil, ErrSnapshotFormat
+    }
+    fields := strings.Split(string(data[nl+1:]), "\x00")
+    if len(fields) > 0 && fields[len(fields)-1] == "" {
+        fields = fields[:len(fields)-1]
+    }
+    next := func() (string, bool) {
+        if len(fields) == 0 {
+            return "", false
+        }
+        f := fields[0]
+        fields = fields[1:]
+        return f, true
+    }
+    num := func() (int64, error) {
+        f, ok := next()
+        if !ok {
+            return 0, ErrSnapshotFormat
+        }
+        n, err := strconv.ParseInt(f, 10, 64)
+        if err != nil {
+            // Device and inode numbers may use the whole unsigned range.
+            u, uerr := strconv.ParseUint(f, 10, 64)
+            if uerr != nil {
+                return 0, ErrSnapshotFormat
+            }
+            n = int64(u)
+        }
+        return n, nil
+    }
+    snap := &snapshot{dirs: map[string]*snapshotDir{}}
+    sec, err := num()
+    if err != nil {
+        return nil, err
+    }
+    nsec, err := num()
+    if err != nil {
+        return nil, err
+    }
+    snap.time = time.Unix(sec, nsec)
+    for len(fields) > 0 {
+        var v [5]int64
+        for i := range v {
+            if v[i], err = num(); err != nil {
+                return nil, err
+            }
+        }
+        name, ok := next()
+        if !ok {
+            return nil, ErrSnapshotFormat
+        }
+        d := &snapshotDir{nfs: v[0] != 0, mtime: time.Unix(v[1], v[2]), dev: uint64(v[3]), ino: uint64(v[4])}
+        for {
+            e, ok := next()
+            if !ok {
+                return nil, ErrSnapshotFormat
+            }
+            if e == "" {
+                break
+            }
+            d.dumpdir = append(d.dumpdir, e)
+        }
+        // Each record ends with a second empty field.
+        if e, ok := next(); ok && e != "" {
+            return nil, ErrSnapshotFormat
+        }
+        if _, dup := snap.dirs[name]; !dup {
+            snap.order = append(snap.order, name)
+        }
+        snap.dirs[name] = d
+    }
+    return snap, nil
+}
+
+// writeTo writes the snapshot in GNU tar's version 2 format.
+func (s *snapshot) writeTo(w io.Writer) error {
+    bw := bufio.NewWriter(w)
+    bw.WriteString(snapshotMagic)
+    fmt.Fprintf(bw, "%d\x00%d\x00", s.time.Unix(), s.time.Nanosecond())
+    for _, name := range s.order {
+        d := s.dirs[name]
+        nfs := 0
+        if d.nfs {
+            nfs = 1
+        }
+        fmt.Fprintf(bw, "%d\x00%d\x00%d\x00%d\x00%d\x00%s\x00", nfs, d.mtime.Unix(), d.mtime.Nanosecond(), d.dev, d.ino, name)
+        for _, e := range d.dumpdir {
+            bw.WriteString(e)
+            bw.WriteByte(0)
+        }
+        bw.WriteString("\x00\x00")
+    }
+    return bw.Flush()
+}
+
+// writeSnapshot replaces the snapshot file name atomically.
+func writeSnapshot(name string, s *snapshot) (err error) {
+    f, err := ioutil.TempFile(filepath.Dir(name), ".snapshot-")
+    if err != nil {
+        return err
+    }
+    defer func() {
+        if err != nil {
+            f.Close()
+            os.Remove(f.Name())
+        }
+    }()
+    if err := s.writeTo(f); err != nil {
+        return err
+    }
+    if err := f.Close(); err != nil {
+        return err
+    }
+    return os.Rename(f.Name(), name)
+}
+
+// incrementalName returns the GNU member name for a walkSources name.
+func incrementalName(name string) string { return "./" + name }
+
+// snapshotName returns the snapshot record name for the directory with
+// the given walkSources name.
+func snapshotName(dir string) string {
+    if dir == "" {
+        return "."
+    }
+    return "./" + strings.TrimSuffix(dir, "/")
+}
+
+// WriteIncremental writes the tree at src to w as a GNU incremental tar
+// stream and updates the snapshot file. If the snapshot file does not
+// exist or is empty the dump is a full one; otherwise only files modified
+// since the dump that wrote it are included. The snapshot is replaced only
+// once the whole stream has been written.
+func WriteIncremental(ctx context.Context, w io.Writer, src, snapshotFile string, opts *CreateOptions) error {
+    if opts == nil {
+        opts = &CreateOptions{}
+    }
+    prev, err := readSnapshot(snapshotFile)
+    if err != nil {
+        return err
+    }
+    // File timestamps come from a coarse clock that can lag the wall
+    // clock, so the start time is backed off to not miss changes made
+    // just after it; files changed just before are dumped again.
+    start := time.Now().Add(-snapshotSlack)
+    rootInfo, err := os.Stat(src)
+    if err != nil {
+        return err
+    }
+    if !rootInfo.IsDir() {
+        return &os.PathError{Op: "dump", Path: src, Err: errors.New("not a directory")}
+    }
+    srcs, _, err := walkSources(ctx, src)
+    if err != nil {
+        return err
+    }
+    srcs = append([]source{{path: src, name: "", info: rootInfo}}, srcs...)
+
+    children := map[string][]int{}
+    for i, s := range srcs[1:] {
+        parent := path.Dir(strings.TrimSuffix(s.name, "/"))
+        if parent == "." {
+            parent = ""
+        } else {
+            parent += "/"
+        }
+        children[parent] = append(children[parent], i+1)
+    }
+
+    next := &snapshot{time: start, dirs: map[string]*snapshotDir{}}
+    var dirs []source
+    var dumpdirs [][]byte
+    var files []source
+    var total int64
+    for _, s := range srcs {
+        if !s.info.IsDir() {
+            continue
+        }
+        id := statIdentity(s.info)
+        sname := snapshotName(s.name)
+        // Everything in a directory that is new, or was replaced since
+        // the last dump, is dumped again.
+        fresh := prev == nil
+        if !fresh {
+            old := prev.dirs[sname]
+            fresh = old == nil || (id.known && (old.dev != id.dev || old.ino != id.ino))
+        }
+        d := &snapshotDir{mtime: s.info.ModTime(), dev: id.dev, ino: id.ino}
+        var dumpdir []byte
+        for _, i := range children[s.name] {
+            c := srcs[i]
+            base := path.Base(strings.TrimSuffix(c.name, "/"))
+            code := byte(dumpdirUnchanged)
+            switch {
+            case c.info.IsDir():
+                code = dumpdirDir
+            case fresh || !statIdentity(c.info).olderThan(c.info, prev.time):
+                code = dumpdirIncluded
+                files = append(files, c)
+                if c.info.Mode().IsRegular() {
+                    total += c.info.Size()
+                }
+            }
+            d.dumpdir = append(d.dumpdir, string(code)+base)
+            dumpdir = append(append(append(dumpdir, code), base...), 0)
+        }
+        dumpdirs = append(dumpdirs, append(dumpdir, 0))
+        dirs = append(dirs, s)
+        next.order = append(next.order, sname)
+        next.dirs[sname] = d
+    }
+
+    tk := newTracker(opts.Progress, len(dirs)+len(files), total)
+    tw := tar.NewWriter(&ctxWriter{ctx: ctx, w: w, count: tk.addOut})
+    // All directories come first, so a restore removes deleted entries
+    // before writing the files that replace them.
+    for i, s := range dirs {
+        name := incrementalName(s.name)
+        tk.begin(name)
+        hdr, err := tar.FileInfoHeader(s.info, "")
+        if err != nil {
+            return err
+        }
+        hdr.Name = name
+        if !strings.HasSuffix(hdr.Name, "/") {
+            hdr.Name += "/"
+        }
+        hdr.Typeflag = TypeGNUDumpdir
+        hdr.Size = int64(len(dumpdirs[i]))
+        if err := tw.WriteHeader(hdr); err != nil {
+            return err
+        }
+        if _, err := tw.Write(dumpdirs[i]); err != nil {
+            return err
+        }
+        tk.entryDone()
+    }
+    for _, s := range files {
+        s.name = incrementalName(s.name)
+        tk.begin(s.name)
+        if err := writeTarSource(ctx, tw, s, tk); err != nil {
+            return err
+        }
+        tk.entryDone()
+    }
+    if err := tw.Close(); err != nil {
+        return err
+    }
+    if err := writeSnapshot(snapshotFile, next); err != nil {
+        return err
+    }
+    tk.done()
+    return nil
+}
+
+// fileIdentity is the part of a file's status that is not in os.FileInfo.
+type fileIdentity struct {
+    known    bool // false where the platform does not report it
+    dev, ino uint64
+    ctime    time.Time
+}
+
+// olderThan reports whether neither the content nor the status of the
+// file described by fi changed at or after t.
+func (id fileIdentity) olderThan(fi os.FileInfo, t time.Time) bool {
+    if !fi.ModTime().Before(t) {
+        return false
+    }
+    return !id.known || id.ctime.Before(t)
+}
+
+// ExtractIncremental applies one GNU incremental tar stream, gzipped or
+// not, onto dst. Each directory member removes the entries of its
+// directory that are not listed in its dumpdir, and files are written over
+// what is there. Unlike ExtractTar, nothing is rolled back on failure.
+func ExtractIncremental(ctx context.Context, dst string, r io.Reader) error {
+    br := bufio.NewReader(r)
+    var in io.Reader = br
+    if magic, _ := br.Peek(2); len(magic) == 2 && magic[0] == 0x1f && magic[1] == 0x8b {
+        gz, err := gzip.NewReader(br)
+        if err != nil {
+            return err
+        }
+        defer gz.Close()
+        in = gz
+    }
+    x := newExtractor(ctx, dst, &ExtractOptions{}, newTracker(nil, -1, -1))
+    tr := tar.NewReader(&ctxReader{ctx: ctx, r: in})
+    for {
+        hdr, err := tr.Next()
+        if err == io.EOF {
+            break
+        }
+        if err != nil {
+            return err
+        }
+        if hdr.Typeflag != TypeGNUDumpdir {
+            if err := replaceEntry(x, hdr); err != nil {
+                return err
+            }
+            if err := x.extract(hdr, tr); err != nil {
+                return err
+            }
+            continue
+        }
+        dumpdir, err := ioutil.ReadAll(io.LimitReader(tr, hdr.Size))
+        if err != nil {
+            return err
+        }
+        dir := *hdr
+        dir.Typeflag = tar.TypeDir
+        if err := replaceEntry(x, &dir); err != nil {
+            return err
+        }
+        if err := x.extract(&dir, nil); err != nil {
+            return err
+        }
+        if err := purgeDumpdir(x, hdr.Name, dumpdir); err != nil {
+            return err
+        }
+    }
+    return x.finish()
+}
+
+// purgeDumpdir removes the entries of the directory name that its dumpdir
+// does not list.
+func purgeDumpdir(x *extractor, name string, dumpdir []byte) error {
+    clean, err := cleanName(name)
+    if err != nil {
+        return &EntryError{Name: name, Err: err}
+    }
+    keep := map[string]bool{}
+    for _, e := range bytes.Split(dumpdir, []byte{0}) {
+        if len(e) < 2 {
+            continue
+        }
+        switch e[0] {
+        case dumpdirIncluded, dumpdirUnchanged, dumpdirDir:
+            keep[path.Join(clean, string(e[1:]))] = true
+        }
+    }
+    return applyOpaque(x, clean, keep)
+}
+
+// RestoreIncremental applies the incremental archive files in order,
+// the full dump first, onto dst.
+func RestoreIncremental(ctx context.Context, dst string, archives ...string) error {
+    for _, name := range archives {
+        f, err := os.Open(name)
+        if err != nil {
+            return err
+        }
+        err = ExtractIncremental(ctx, dst, f)
+        f.Close()
+        if err != nil {
+            return err
+        }
+    }
+    return nil
+}
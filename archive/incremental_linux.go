@@ -0,0 +1,17 @@
+package archive
+
+import (
+    "os"
+    "syscall"
+    "time"
+)
+
+// statIdentity returns the device, inode and status change time of fi.
+func statIdentity(fi os.FileInfo) fileIdentity {
+    st, ok := fi.Sys().(*syscall.Stat_t)
+    if !ok {
+        return fileIdentity{}
+    }
+    sec, nsec := st.Ctim.Unix()
+    return fileIdentity{known: true, dev: uint64(st.Dev), ino: uint64(st.Ino), ctime: time.Unix(sec, nsec)}
+}
@@ -0,0 +1,197 @@
+package archive
+
+import (
+    "archive/tar"
+    "bytes"
+    "context"
+    "io"
+    "io/ioutil"
+    "os"
+    "os/exec"
+    "path/filepath"
+    "sort"
+    "strings"
+    "testing"
+    "time"
+)
+
+// dumpMembers returns the member names of a tar stream and the dumpdir
+// of each directory member.
+func dumpMembers(t *testing.T, blob []byte) ([]string, map[string]string) {
+    tr := tar.NewReader(bytes.NewReader(blob))
+    var names []string
+    dumpdirs := map[string]string{}
+    for {
+        hdr, err := tr.Next()
+        if err == io.EOF {
+            return names, dumpdirs
+        }
+        if err != nil {
+            t.Fatal(err)
+        }
+        names = append(names, hdr.Name)
+        if hdr.Typeflag == TypeGNUDumpdir {
+            b, err := ioutil.ReadAll(tr)
+            if err != nil {
+                t.Fatal(err)
+            }
+            dumpdirs[hdr.Name] = string(b)
+        }
+    }
+}
+
+func writeDump(t *testing.T, src, snap, out string) []byte {
+    var buf bytes.Buffer
+    if err := WriteIncremental(context.Background(), &buf, src, snap, nil); err != nil {
+        t.Fatal(err)
+    }
+    if err := ioutil.WriteFile(out, buf.Bytes(), 0644); err != nil {
+        t.Fatal(err)
+    }
+    return buf.Bytes()
+}
+
+func TestIncrementalChain(t *testing.T) {
+    src, work, dst := tempDir(t), tempDir(t), tempDir(t)
+    writeTree(t, src, map[string]string{
+        "keep.txt":       "unchanged",
+        "edit.txt":       "v1",
+        "gone.txt":       "deleted later",
+        "swap":           "becomes a directory",
+        "old/inner.txt":  "whole directory deleted",
+        "sub/stay.txt":   "unchanged too",
+        "sub/change.txt": "v1",
+    })
+    snap := filepath.Join(work, "snapshot")
+    level0 := filepath.Join(work, "level0.tar")
+    level1 := filepath.Join(work, "level1.tar")
+    // Leave the tree older than the dump's snapshot time.
+    time.Sleep(2 * snapshotSlack)
+
+    names, _ := dumpMembers(t, writeDump(t, src, snap, level0))
+    if len(names) != 10 {
+        t.Fatalf("full dump has %d members, want 10: %v", len(names), names)
+    }
+    time.Sleep(2 * snapshotSlack)
+
+    writeTree(t, src, map[string]string{
+        "edit.txt":       "v2",
+        "sub/change.txt": "v2",
+        "sub/new.txt":    "added",
+    })
+    os.Remove(filepath.Join(src, "gone.txt"))
+    os.RemoveAll(filepath.Join(src, "old"))
+    os.Remove(filepath.Join(src, "swap"))
+    writeTree(t, src, map[string]string{"swap/file": "now inside"})
+    time.Sleep(2 * snapshotSlack)
+
+    names, dumpdirs := dumpMembers(t, writeDump(t, src, snap, level1))
+    sort.Strings(names)
+    want := []string{"./", "./edit.txt", "./sub/", "./sub/change.txt", "./sub/new.txt", "./swap/", "./swap/file"}
+    if strings.Join(names, " ") != strings.Join(want, " ") {
+        t.Errorf("incremental members = %v, want %v", names, want)
+    }
+    if got, want := dumpdirs["./"], "Yedit.txt\x00Nkeep.txt\x00Dsub\x00Dswap\x00\x00"; got != want {
+        t.Errorf("root dumpdir = %q, want %q", got, want)
+    }
+
+    if err := RestoreIncremental(context.Background(), dst, level0, level1); err != nil {
+        t.Fatal(err)
+    }
+    checkTree(t, dst, map[string]string{
+        "keep.txt":       "unchanged",
+        "edit.txt":       "v2",
+        "swap/file":      "now inside",
+        "sub/stay.txt":   "unchanged too",
+        "sub/change.txt": "v2",
+        "sub/new.txt":    "added",
+    })
+    if _, err := os.Stat(filepath.Join(dst, "old")); !os.IsNotExist(err) {
+        t.Errorf("deleted directory restored: %v", err)
+    }
+
+    // A dump with nothing changed lists everything as unchanged.
+    names, _ = dumpMembers(t, writeDump(t, src, snap, filepath.Join(work, "level2.tar")))
+    for _, name := range names {
+        if !strings.HasSuffix(name, "/") {
+            t.Errorf("unchanged tree dumped %s", name)
+        }
+    }
+}
+
+func TestSnapshotRoundTrip(t *testing.T) {
+    dir := tempDir(t)
+    name := filepath.Join(dir, "snap")
+    data := "GNU tar-1.34-2\n1792394702\x0089390909\x00" +
+        "0\x001792394701\x0066896597\x0065024\x009625617\x00.\x00Nlink\x00Dsub\x00\x00\x00" +
+        "0\x001792394702\x0082605661\x0065024\x009625633\x00./sub\x00\x00\x00"
+    if err := ioutil.WriteFile(name, []byte(data), 0644); err != nil {
+        t.Fatal(err)
+    }
+    snap, err := readSnapshot(name)
+    if err != nil {
+        t.Fatal(err)
+    }
+    if len(snap.order) != 2 || snap.dirs["./sub"].ino != 9625633 || len(snap.dirs["."].dumpdir) != 2 {
+        t.Fatalf("parsed %+v", snap)
+    }
+    var buf bytes.Buffer
+    if err := snap.writeTo(&buf); err != nil {
+        t.Fatal(err)
+    }
+    if got := strings.SplitN(buf.String(), "\n", 2)[1]; got != strings.SplitN(data, "\n", 2)[1] {
+        t.Errorf("rewritten snapshot = %q", got)
+    }
+
+    ioutil.WriteFile(name, []byte("GNU tar-1.34-1\n"), 0644)
+    if _, err := readSnapshot(name); err != ErrSnapshotFormat {
+        t.Errorf("version 1 snapshot: err = %v", err)
+    }
+}
+
+// TestIncrementalGNUTar checks that GNU tar continues a chain started by
+// WriteIncremental and restores it.
+func TestIncrementalGNUTar(t *testing.T) {
+    out, err := exec.Command("tar", "--version").Output()
+    if err != nil || !bytes.Contains(out, []byte("GNU tar")) {
+        t.Skip("GNU tar not available")
+    }
+    src, work, dst := tempDir(t), tempDir(t), tempDir(t)
+    writeTree(t, src, map[string]string{"a.txt": "a", "gone.txt": "gone", "sub/b.txt": "b"})
+    snap := filepath.Join(work, "snapshot")
+    level0 := filepath.Join(work, "level0.tar")
+    level1 := filepath.Join(work, "level1.tar")
+    // Leave the tree older than the dump's snapshot time.
+    time.Sleep(2 * snapshotSlack)
+    writeDump(t, src, snap, level0)
+    time.Sleep(2 * snapshotSlack)
+
+    writeTree(t, src, map[string]string{"sub/c.txt": "c"})
+    os.Remove(filepath.Join(src, "gone.txt"))
+    if out, err := exec.Command("tar", "--listed-incremental="+snap, "-C", src, "-cf", level1, ".").CombinedOutput(); err != nil {
+        t.Fatalf("tar: %v\n%s", err, out)
+    }
+    blob, err := ioutil.ReadFile(level1)
+    if err != nil {
+        t.Fatal(err)
+    }
+    names, _ := dumpMembers(t, blob)
+    if len(names) != 3 || names[2] != "./sub/c.txt" {
+        t.Errorf("GNU tar incremental members = %v", names)
+    }
+
+    for _, level := range []string{level0, level1} {
+        cmd := exec.Command("tar", "--listed-incremental=/dev/null", "-C", dst, "-xf", level)
+        if out, err := cmd.CombinedOutput(); err != nil {
+            t.Fatalf("tar: %v\n%s", err, out)
+        }
+    }
+    want := map[string]string{"a.txt": "a", "sub/b.txt": "b", "sub/c.txt": "c"}
+    checkTree(t, dst, want)
+
+    ours := tempDir(t)
+    if err := RestoreIncremental(context.Background(), ours, level0, level1); err != nil {
+        t.Fatal(err)
+    }
+    checkTree(t, ours, want)
+}
@@ -0,0 +1,383 @@
+package archive
+
+import (
+    "archive/tar"
+    "bufio"
+    "compress/gzip"
+    "context"
+    "crypto/sha256"
+    "encoding/hex"
+    "hash"
+    "io"
+    "io/ioutil"
+    "os"
+    "path"
+    "path/filepath"
+    "sort"
+    "strings"
+)
+
+const (
+    // WhiteoutPrefix marks a layer entry that deletes the path named by
+    // the rest of its base name from the layers below.
+    WhiteoutPrefix = ".wh."
+    // WhiteoutOpaque marks a directory whose lower layer contents are hidden.
+    WhiteoutOpaque = WhiteoutPrefix + WhiteoutPrefix + ".opq"
+)
+
+// Layer describes a layer blob the way OCI image manifests and configs
+// refer to it.
+type Layer struct {
+    Digest string // sha256 of the blob as stored, possibly compressed
+    DiffID string // sha256 of the uncompressed tar stream
+    Size   int64  // size of the blob as stored
+}
+
+// layerChange is one entry of a layer being built.
+type layerChange struct {
+    name string  // archive name, without a trailing slash
+    src  *source // nil for whiteouts
+}
+
+// rank orders the entries of one directory: the opaque marker first, then
+// whiteouts, then regular content.
+func (c layerChange) rank() int {
+    switch base := path.Base(c.name); {
+    case base == WhiteoutOpaque:
+        return 0
+    case strings.HasPrefix(base, WhiteoutPrefix):
+        return 1
+    }
+    return 2
+}
+
+// WriteLayer writes a layer tarball holding the changes that turn the tree
+// at lower into the tree at upper. An empty lower produces a base layer.
+// Deleted paths become .wh. whiteouts, and a directory whose lower content
+// was entirely replaced is marked opaque. With compress set the tarball is
+// gzipped.
+func WriteLayer(ctx context.Context, w io.Writer, lower, upper string, compress bool) (*Layer, error) {
+    changes, err := diffTrees(ctx, lower, upper)
+    if err != nil {
+        return nil, err
+    }
+    blobHash, diffHash := sha256.New(), sha256.New()
+    cw := &countWriter{w: io.MultiWriter(w, blobHash)}
+    var out io.Writer = cw
+    var gz *gzip.Writer
+    if compress {
+        gz = gzip.NewWriter(cw)
+        out = gz
+    }
+    tw := tar.NewWriter(io.MultiWriter(out, diffHash))
+    tk := newTracker(nil, 0, 0)
+    for _, c := range changes {
+        if err := ctx.Err(); err != nil {
+            return nil, err
+        }
+        if c.src == nil {
+            hdr := &tar.Header{Name: c.name, Typeflag: tar.TypeReg, Mode: 0644}
+            if err := tw.WriteHeader(hdr); err != nil {
+                return nil, err
+            }
+            continue
+        }
+        if err := writeTarSource(ctx, tw, *c.src, tk); err != nil {
+            return nil, err
+        }
+    }
+    if err := tw.Close(); err != nil {
+        return nil, err
+    }
+    if gz != nil {
+        if err := gz.Close(); err != nil {
+            return nil, err
+        }
+    }
+    return &Layer{
+        Digest: digestOf(blobHash),
+        DiffID: digestOf(diffHash),
+        Size:   cw.n,
+    }, nil
+}
+
+// diffTrees lists the layer entries that turn lower into upper.
+func diffTrees(ctx context.Context, lower, upper string) ([]layerChange, error) {
+    lowerSrcs := map[string]*source{}
+    if lower != "" {
+        srcs, _, err := walkSources(ctx, lower)
+        if err != nil {
+            return nil, err
+        }
+        for i := range srcs {
+            lowerSrcs[strings.TrimSuffix(srcs[i].name, "/")] = &srcs[i]
+        }
+    }
+    srcs, _, err := walkSources(ctx, upper)
+    if err != nil {
+        return nil, err
+    }
+    upperSrcs := map[string]*source{}
+    for i := range srcs {
+        upperSrcs[strings.TrimSuffix(srcs[i].name, "/")] = &srcs[i]
+    }
+
+    // A directory present in both trees is opaque when none of its lower
+    // children survive.
+    lowerKids, keptKids := map[string]int{}, map[string]int{}
+    for name := range lowerSrcs {
+        dir := path.Dir(name)
+        lowerKids[dir]++
+        if _, ok := upperSrcs[name]; ok {
+            keptKids[dir]++
+        }
+    }
+    opaque := map[string]bool{}
+    for dir, n := range lowerKids {
+        l, u := lowerSrcs[dir], upperSrcs[dir]
+        if dir != "." && l != nil && u != nil && l.info.IsDir() && u.info.IsDir() && n > 0 && keptKids[dir] == 0 {
+            opaque[dir] = true
+        }
+    }
+
+    var changes []layerChange
+    for name, u := range upperSrcs {
+        l := lowerSrcs[name]
+        if l == nil || opaque[name] || sourceChanged(l, u) {
+            changes = append(changes, layerChange{name: name, src: u})
+        }
+        if opaque[name] {
+            changes = append(changes, layerChange{name: name + "/" + WhiteoutOpaque})
+        }
+    }
+    for name := range lowerSrcs {
+        if _, ok := upperSrcs[name]; ok {
+            continue
+        }
+        dir := path.Dir(name)
+        if dir != "." {
+            // Children of deleted, replaced or opaque directories are
+            // already hidden.
+            if u := upperSrcs[dir]; u == nil || !u.info.IsDir() || opaque[dir] {
+                continue
+            }
+        }
+        changes = append(changes, layerChange{name: path.Join(dir, WhiteoutPrefix+path.Base(name))})
+    }
+    sort.Slice(changes, func(i, j int) bool {
+        a, b := changes[i], changes[j]
+        if da, db := path.Dir(a.name), path.Dir(b.name); da != db {
+            return da < db
+        }
+        if ra, rb := a.rank(), b.rank(); ra != rb {
+            return ra < rb
+        }
+        return a.name < b.name
+    })
+    return changes, nil
+}
+
+// sourceChanged reports whether u differs from l in type, metadata or, for
+// symlinks, target.
+func sourceChanged(l, u *source) bool {
+    li, ui := l.info, u.info
+    if li.Mode() != ui.Mode() {
+        return true
+    }
+    if ui.IsDir() {
+        return !li.ModTime().Equal(ui.ModTime())
+    }
+    if li.Size() != ui.Size() || !li.ModTime().Equal(ui.ModTime()) {
+        return true
+    }
+    if ui.Mode()&os.ModeSymlink != 0 {
+        lt, _ := os.Readlink(l.path)
+        ut, _ := os.Readlink(u.path)
+        return lt != ut
+    }
+    lh, lu := tarOwner(li)
+    uh, uu := tarOwner(ui)
+    return lh != uh || lu != uu
+}
+
+// tarOwner returns the owner ids tar would record for fi.
+func tarOwner(fi os.FileInfo) (int, int) {
+    hdr, err := tar.FileInfoHeader(fi, "")
+    if err != nil {
+        return 0, 0
+    }
+    return hdr.Uid, hdr.Gid
+}
+
+// ApplyLayer applies a layer tarball, gzipped or not, onto root. Whiteouts
+// delete paths created by earlier layers; an opaque marker empties its
+// directory of everything not added by this layer.
+func ApplyLayer(ctx context.Context, root string, r io.Reader) error {
+    br := bufio.NewReader(r)
+    var in io.Reader = br
+    if magic, _ := br.Peek(2); len(magic) == 2 && magic[0] == 0x1f && magic[1] == 0x8b {
+        gz, err := gzip.NewReader(br)
+        if err != nil {
+            return err
+        }
+        defer gz.Close()
+        in = gz
+    }
+    x := newExtractor(ctx, root, &ExtractOptions{}, newTracker(nil, -1, -1))
+    added := map[string]bool{}
+    tr := tar.NewReader(&ctxReader{ctx: ctx, r: in})
+    for {
+        hdr, err := tr.Next()
+        if err == io.EOF {
+            break
+        }
+        if err != nil {
+            return err
+        }
+        name, err := cleanName(hdr.Name)
+        if err != nil {
+            return &EntryError{Name: hdr.Name, Err: err}
+        }
+        dir, base := path.Dir(name), path.Base(name)
+        switch {
+        case base == WhiteoutOpaque:
+            if err := applyOpaque(x, dir, added); err != nil {
+                return err
+            }
+        case strings.HasPrefix(base, WhiteoutPrefix):
+            target, err := x.target(path.Join(dir, base[len(WhiteoutPrefix):]))
+            if err != nil {
+                return err
+            }
+            if err := os.RemoveAll(target); err != nil {
+                return err
+            }
+        default:
+            if err := replaceEntry(x, hdr); err != nil {
+                return err
+            }
+            if err := x.extract(hdr, tr); err != nil {
+                return err
+            }
+            added[name] = true
+        }
+    }
+    return x.finish()
+}
+
+// replaceEntry removes whatever is in the way of hdr, keeping existing
+// directories that hdr describes as directories.
+func replaceEntry(x *extractor, hdr *tar.Header) error {
+    target, err := x.target(hdr.Name)
+    if err != nil || target == "" {
+        return err
+    }
+    fi, err := os.Lstat(target)
+    if os.IsNotExist(err) {
+        return nil
+    }
+    if err != nil {
+        return err
+    }
+    if fi.IsDir() && hdr.Typeflag == tar.TypeDir {
+        return nil
+    }
+    return os.RemoveAll(target)
+}
+
+// applyOpaque removes the children of dir that were not added by the
+// layer being applied.
+func applyOpaque(x *extractor, dir string, added map[string]bool) error {
+    target := x.dst
+    if dir != "." {
+        var err error
+        if target, err = x.target(dir); err != nil {
+            return err
+        }
+    }
+    infos, err := ioutil.ReadDir(target)
+    if err != nil {
+        if os.IsNotExist(err) {
+            return nil
+        }
+        return err
+    }
+    for _, fi := range infos {
+        if added[path.Join(dir, fi.Name())] {
+            continue
+        }
+        if err := os.RemoveAll(filepath.Join(target, fi.Name())); err != nil {
+            return err
+        }
+    }
+    return nil
+}
+
+// ApplyLayers applies the layer files in order, lowest first, onto root.
+func ApplyLayers(ctx context.Context, root string, layers ...string) error {
+    for _, name := range layers {
+        f, err := os.Open(name)
+        if err != nil {
+            return err
+        }
+        err = ApplyLayer(ctx, root, f)
+        f.Close()
+        if err != nil {
+            return err
+        }
+    }
+    return nil
+}
+
+// LayerDigests computes the digest, diffID and size of the layer blob in r.
+func LayerDigests(r io.Reader) (*Layer, error) {
+    blobHash, diffHash := sha256.New(), sha256.New()
+    cr := &countReader{r: io.TeeReader(r, blobHash)}
+    br := bufio.NewReader(cr)
+    var in io.Reader = br
+    if magic, _ := br.Peek(2); len(magic) == 2 && magic[0] == 0x1f && magic[1] == 0x8b {
+        gz, err := gzip.NewReader(br)
+        if err != nil {
+            return nil, err
+        }
+        in = gz
+    }
+    if _, err := copyBuffer(diffHash, in); err != nil {
+        return nil, err
+    }
+    // Hash any trailing bytes the decompressor did not need.
+    if _, err := io.Copy(ioutil.Discard, br); err != nil {
+        return nil, err
+    }
+    return &Layer{
+        Digest: digestOf(blobHash),
+        DiffID: digestOf(diffHash),
+        Size:   cr.n,
+    }, nil
+}
+
+func digestOf(h hash.Hash) string {
+    return "sha256:" + hex.EncodeToString(h.Sum(nil))
+}
+
+type countWriter struct {
+    w io.Writer
+    n int64
+}
+
+func (w *countWriter) Write(p []byte) (int, error) {
+    n, err := w.w.Write(p)
+    w.n += int64(n)
+    return n, err
+}
+
+type countReader struct {
+    r io.Reader
+    n int64
+}
+
+func (r *countReader) Read(p []byte) (int, error) {
+    n, err := r.r.Read(p)
+    r.n += int64(n)
+    return n, err
+}
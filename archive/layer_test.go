@@ -0,0 +1,116 @@
+package archive
+
+import (
+    "archive/tar"
+    "bytes"
+    "compress/gzip"
+    "context"
+    "io"
+    "os"
+    "path/filepath"
+    "testing"
+    "time"
+)
+
+func layerNames(t *testing.T, blob []byte) []string {
+    gz, err := gzip.NewReader(bytes.NewReader(blob))
+    if err != nil {
+        t.Fatal(err)
+    }
+    var names []string
+    tr := tar.NewReader(gz)
+    for {
+        hdr, err := tr.Next()
+        if err == io.EOF {
+            return names
+        }
+        if err != nil {
+            t.Fatal(err)
+        }
+        names = append(names, hdr.Name)
+    }
+}
+
+func TestLayerRoundTrip(t *testing.T) {
+    lower, upper := tempDir(t), tempDir(t)
+    base := map[string]string{
+        "etc/os-release":    "ID=gopher",
+        "etc/hosts":         "127.0.0.1 localhost",
+        "var/cache/a":       "a",
+        "var/cache/b":       "b",
+        "usr/share/doc/old": "old docs",
+        "usr/bin/tool":      "v1",
+    }
+    writeTree(t, lower, base)
+    writeTree(t, upper, base)
+    // Keep unchanged files identical, including mtimes.
+    old := time.Unix(1e9, 0)
+    for _, root := range []string{lower, upper} {
+        filepath.Walk(root, func(p string, fi os.FileInfo, err error) error {
+            return os.Chtimes(p, old, old)
+        })
+    }
+    os.Remove(filepath.Join(upper, "etc/hosts"))
+    os.RemoveAll(filepath.Join(upper, "usr/share"))
+    os.Remove(filepath.Join(upper, "var/cache/a"))
+    os.Remove(filepath.Join(upper, "var/cache/b"))
+    want := map[string]string{
+        "etc/os-release": "ID=gopher",
+        "var/cache/c":    "c",
+        "usr/bin/tool":   "v2",
+        "opt/new":        "new",
+    }
+    writeTree(t, upper, map[string]string{"var/cache/c": "c", "usr/bin/tool": "v2", "opt/new": "new"})
+
+    var baseBlob, diffBlob bytes.Buffer
+    baseLayer, err := WriteLayer(context.Background(), &baseBlob, "", lower, true)
+    if err != nil {
+        t.Fatal(err)
+    }
+    diffLayer, err := WriteLayer(context.Background(), &diffBlob, lower, upper, true)
+    if err != nil {
+        t.Fatal(err)
+    }
+
+    names := map[string]bool{}
+    for _, n := range layerNames(t, diffBlob.Bytes()) {
+        names[n] = true
+    }
+    for _, n := range []string{"etc/.wh.hosts", "usr/.wh.share", "var/cache/.wh..wh..opq", "var/cache/c", "usr/bin/tool", "opt/new"} {
+        if !names[n] {
+            t.Errorf("layer lacks %s: %v", n, names)
+        }
+    }
+    if names["etc/os-release"] || names["usr/share/doc/.wh.old"] || names["var/cache/.wh.a"] {
+        t.Errorf("layer has redundant entries: %v", names)
+    }
+
+    dir := tempDir(t)
+    for _, blob := range []*bytes.Buffer{&baseBlob, &diffBlob} {
+        p := filepath.Join(dir, "layer.tar.gz")
+        f, _ := os.Create(p)
+        f.Write(blob.Bytes())
+        f.Close()
+        root := filepath.Join(dir, "root")
+        if err := ApplyLayers(context.Background(), root, p); err != nil {
+            t.Fatal(err)
+        }
+    }
+    checkTree(t, filepath.Join(dir, "root"), want)
+
+    for _, c := range []struct {
+        blob  []byte
+        layer *Layer
+    }{{baseBlob.Bytes(), baseLayer}, {diffBlob.Bytes(), diffLayer}} {
+        got, err := LayerDigests(bytes.NewReader(c.blob))
+        if err != nil {
+            t.Fatal(err)
+        }
+        if *got != *c.layer {
+            t.Errorf("LayerDigests = %+v, WriteLayer = %+v", got, c.layer)
+        }
+    }
+    if baseLayer.Digest == baseLayer.DiffID {
+        t.Error("compressed layer has digest equal to diffID")
+    }
+}
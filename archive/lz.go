@@ -0,0 +1,225 @@
+package archive
+
+import "sort"
+
+const (
+    lzHashBits = 16
+    lzMinMatch = 3
+)
+
+// matcher finds earlier occurrences of the bytes at a position, for the
+// LZ77 style encoders of the extra zip compression methods. It keeps a
+// sliding buffer of history followed by data not yet encoded, and hash
+// chains over every position inserted so far.
+type matcher struct {
+    window int // maximum match distance
+    maxLen int // maximum match length
+    chain  int // maximum number of candidates examined per search
+    buf    []byte
+    head   []int32 // hash -> last position + 1 with that hash
+    prev   []int32 // position -> previous position + 1 with the same hash
+    next   int     // positions before next have been inserted
+}
+
+func newMatcher(window, maxLen, chain int) *matcher {
+    return &matcher{window: window, maxLen: maxLen, chain: chain, head: make([]int32, 1<<lzHashBits)}
+}
+
+// write appends p to the buffer.
+func (m *matcher) write(p []byte) {
+    m.buf = append(m.buf, p...)
+    for len(m.prev) < len(m.buf) {
+        m.prev = append(m.prev, 0)
+    }
+}
+
+// slide discards history that no match starting at pos or later can
+// reach, and returns the number of bytes dropped from the front of buf.
+// Callers adjust their positions accordingly.
+func (m *matcher) slide(pos int) int {
+    d := pos - m.window
+    if d < m.window {
+        // Not worth moving yet.
+        return 0
+    }
+    copy(m.buf, m.buf[d:])
+    m.buf = m.buf[:len(m.buf)-d]
+    copy(m.prev, m.prev[d:])
+    m.prev = m.prev[:len(m.prev)-d]
+    rebase := func(v []int32) {
+        for i, p := range v {
+            if p -= int32(d); p < 0 {
+                p = 0
+            }
+            v[i] = p
+        }
+    }
+    rebase(m.head)
+    rebase(m.prev)
+    m.next -= d
+    return d
+}
+
+func (m *matcher) hash(i int) int {
+    b := m.buf[i:]
+    return int((uint32(b[0])<<16|uint32(b[1])<<8|uint32(b[2]))*2654435761) >> (32 - lzHashBits)
+}
+
+// insert adds the positions up to end to the hash chains.
+func (m *matcher) insert(end int) {
+    if end > len(m.buf)-lzMinMatch+1 {
+        end = len(m.buf) - lzMinMatch + 1
+    }
+    for ; m.next < end; m.next++ {
+        h := m.hash(m.next)
+        m.prev[m.next] = m.head[h]
+        m.head[h] = int32(m.next + 1)
+    }
+}
+
+// find returns the longest match for the bytes at pos that ends before
+// limit, or a zero length if there is none of at least lzMinMatch bytes.
+// Positions before pos must have been inserted.
+func (m *matcher) find(pos, limit int) (length, dist int) {
+    if limit-pos < lzMinMatch {
+        return 0, 0
+    }
+    max := limit - pos
+    if max > m.maxLen {
+        max = m.maxLen
+    }
+    b := m.buf[pos : pos+max]
+    cand := int(m.head[m.hash(pos)]) - 1
+    if m.next > pos {
+        // pos itself may already be in the chain.
+        for cand >= pos {
+            cand = int(m.prev[cand]) - 1
+        }
+    }
+    for n := m.chain; cand >= 0 && pos-cand <= m.window && n > 0; n-- {
+        c := m.buf[cand:]
+        if c[length] == b[length] {
+            l := 0
+            for l < max && c[l] == b[l] {
+                l++
+            }
+            if l > length {
+                length, dist = l, pos-cand
+                if l == max {
+                    break
+                }
+            }
+        }
+        cand = int(m.prev[cand]) - 1
+    }
+    if length < lzMinMatch {
+        return 0, 0
+    }
+    return length, dist
+}
+
+// deferMatch reports whether a match of the given length at pos should
+// give way to a literal because a longer match starts at pos+1.
+func (m *matcher) deferMatch(pos, limit, length int) bool {
+    if length >= 32 || pos+1 >= limit {
+        return false
+    }
+    m.insert(pos + 1)
+    l, _ := m.find(pos+1, limit)
+    return l > length
+}
+
+// huffmanLengths returns Huffman code lengths of at most maxBits for the
+// symbol frequencies in freq. Unused symbols get length zero; a single
+// used symbol gets length one.
+func huffmanLengths(freq []int, maxBits int) []uint8 {
+    lengths := make([]uint8, len(freq))
+    var syms []int
+    for s, f := range freq {
+        if f > 0 {
+            syms = append(syms, s)
+        }
+    }
+    switch len(syms) {
+    case 0:
+        return lengths
+    case 1:
+        lengths[syms[0]] = 1
+        return lengths
+    }
+    weight := make([]int, len(freq))
+    copy(weight, freq)
+    for {
+        sort.Slice(syms, func(i, j int) bool {
+            if weight[syms[i]] != weight[syms[j]] {
+                return weight[syms[i]] < weight[syms[j]]
+            }
+            return syms[i] < syms[j]
+        })
+        // Two queue construction: leaves in order of weight, and
+        // internal nodes, which are created in order of weight.
+        n := len(syms)
+        parent := make([]int, 2*n-1)
+        w := make([]int, 2*n-1)
+        for i, s := range syms {
+            w[i] = weight[s]
+        }
+        leaf, node := 0, n
+        pick := func(next int) int {
+            if leaf < n && (node >= next || w[leaf] <= w[node]) {
+                leaf++
+                return leaf - 1
+            }
+            node++
+            return node - 1
+        }
+        for next := n; next < 2*n-1; next++ {
+            a := pick(next)
+            b := pick(next)
+            w[next] = w[a] + w[b]
+            parent[a], parent[b] = next, next
+        }
+        depth := make([]int, 2*n-1)
+        longest := 0
+        for i := 2*n - 3; i >= 0; i-- {
+            depth[i] = depth[parent[i]] + 1
+            if i < n && depth[i] > longest {
+                longest = depth[i]
+            }
+        }
+        if longest <= maxBits {
+            for i, s := range syms {
+                lengths[s] = uint8(depth[i])
+            }
+            return lengths
+        }
+        // Flatten the distribution and try again.
+        for _, s := range syms {
+            weight[s] = weight[s]/2 + 1
+        }
+    }
+}
+
+// canonicalCodes assigns canonical Huffman codes, most significant bit
+// first, to the given code lengths.
+func canonicalCodes(lengths []uint8) []uint32 {
+    var count [33]int
+    for _, l := range lengths {
+        count[l]++
+    }
+    count[0] = 0
+    var next [33]uint32
+    code := uint32(0)
+    for l := 1; l < len(next); l++ {
+        code = (code + uint32(count[l-1])) << 1
+        next[l] = code
+    }
+    codes := make([]uint32, len(lengths))
+    for s, l := range lengths {
+        if l > 0 {
+            codes[s] = next[l]
+            next[l]++
+        }
+    }
+    return codes
+}
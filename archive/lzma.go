@@ -0,0 +1,765 @@
+package archive
+
+import (
+    "bufio"
+    "encoding/binary"
+    "errors"
+    "io"
+)
+
+// LZMA in zip (method 14) is a raw LZMA stream behind a small header:
+// the LZMA SDK version (2 bytes), the length of the properties (2 bytes,
+// always 5), the lc/lp/pb byte and the dictionary size (4 bytes).
+
+var errLZMA = errors.New("archive: corrupt lzma data")
+
+const (
+    lzmaStates      = 12
+    lzmaPosBitsMax  = 4
+    lzmaMinMatch    = 2
+    lzmaMaxMatch    = 273
+    lzmaEndPosModel = 14
+    lzmaFullDists   = 1 << (lzmaEndPosModel >> 1)
+    lzmaAlignBits   = 4
+    lzmaProbInit    = 1 << 10
+
+    // Properties used by the encoder.
+    lzmaLC       = 3
+    lzmaLP       = 0
+    lzmaPB       = 2
+    lzmaDictSize = 1 << 22
+)
+
+type prob uint16
+
+func initProbs(p []prob) {
+    for i := range p {
+        p[i] = lzmaProbInit
+    }
+}
+
+// lzmaLenModel holds the probabilities of a match length coder.
+type lzmaLenModel struct {
+    choice  prob
+    choice2 prob
+    low     [1 << lzmaPosBitsMax][1 << 3]prob
+    mid     [1 << lzmaPosBitsMax][1 << 3]prob
+    high    [1 << 8]prob
+}
+
+func (m *lzmaLenModel) init() {
+    m.choice, m.choice2 = lzmaProbInit, lzmaProbInit
+    for i := range m.low {
+        initProbs(m.low[i][:])
+        initProbs(m.mid[i][:])
+    }
+    initProbs(m.high[:])
+}
+
+// lzmaModel holds the adaptive probabilities shared by the encoder and
+// the decoder, and the coder state.
+type lzmaModel struct {
+    lc, lp, pb uint
+    literal    []prob
+    isMatch    [lzmaStates << lzmaPosBitsMax]prob
+    isRep      [lzmaStates]prob
+    isRepG0    [lzmaStates]prob
+    isRepG1    [lzmaStates]prob
+    isRepG2    [lzmaStates]prob
+    isRep0Long [lzmaStates << lzmaPosBitsMax]prob
+    posSlot    [4][1 << 6]prob
+    posSpecial [1 + lzmaFullDists - lzmaEndPosModel]prob
+    align      [1 << lzmaAlignBits]prob
+    matchLen   lzmaLenModel
+    repLen     lzmaLenModel
+
+    state int
+    reps  [4]uint32
+}
+
+func newLZMAModel(lc, lp, pb uint) *lzmaModel {
+    m := &lzmaModel{lc: lc, lp: lp, pb: pb, literal: make([]prob, 0x300<<(lc+lp))}
+    initProbs(m.literal)
+    initProbs(m.isMatch[:])
+    initProbs(m.isRep[:])
+    initProbs(m.isRepG0[:])
+    initProbs(m.isRepG1[:])
+    initProbs(m.isRepG2[:])
+    initProbs(m.isRep0Long[:])
+    for i := range m.posSlot {
+        initProbs(m.posSlot[i][:])
+    }
+    initProbs(m.posSpecial[:])
+    initProbs(m.align[:])
+    m.matchLen.init()
+    m.repLen.init()
+    return m
+}
+
+func (m *lzmaModel) literalProbs(pos int64, prev byte) []prob {
+    i := (uint(pos)&(1<<m.lp-1))<<m.lc + uint(prev)>>(8-m.lc)
+    return m.literal[0x300*i : 0x300*(i+1)]
+}
+
+func (m *lzmaModel) stateLiteral() {
+    switch {
+    case m.state < 4:
+        m.state = 0
+    case m.state < 10:
+        m.state -= 3
+    default:
+        m.state -= 6
+    }
+}
+
+func (m *lzmaModel) stateMatch() {
+    if m.state < 7 {
+        m.state = 7
+    } else {
+        m.state = 10
+    }
+}
+
+func (m *lzmaModel) stateRep() {
+    if m.state < 7 {
+        m.state = 8
+    } else {
+        m.state = 11
+    }
+}
+
+func (m *lzmaModel) stateShortRep() {
+    if m.state < 7 {
+        m.state = 9
+    } else {
+        m.state = 11
+    }
+}
+
+func lenState(length int) int {
+    if length > 3+lzmaMinMatch {
+        return 3
+    }
+    return length - lzmaMinMatch
+}
+
+// rangeDecoder decodes the LZMA range coded bit stream.
+type rangeDecoder struct {
+    br   io.ByteReader
+    rng  uint32
+    code uint32
+    err  error
+}
+
+func (d *rangeDecoder) init() error {
+    b, err := d.br.ReadByte()
+    if err != nil {
+        return unexpected(err)
+    }
+    if b != 0 {
+        return errLZMA
+    }
+    d.rng = 0xffffffff
+    for i := 0; i < 4; i++ {
+        b, err := d.br.ReadByte()
+        if err != nil {
+            return unexpected(err)
+        }
+        d.code = d.code<<8 | uint32(b)
+    }
+    if d.code == d.rng {
+        return errLZMA
+    }
+    return nil
+}
+
+func (d *rangeDecoder) normalize() {
+    if d.rng < 1<<24 {
+        b, err := d.br.ReadByte()
+        if err != nil && d.err == nil {
+            d.err = unexpected(err)
+        }
+        d.rng <<= 8
+        d.code = d.code<<8 | uint32(b)
+    }
+}
+
+func (d *rangeDecoder) bit(p *prob) int {
+    bound := (d.rng >> 11) * uint32(*p)
+    var b int
+    if d.code < bound {
+        d.rng = bound
+        *p += (1<<11 - *p) >> 5
+    } else {
+        d.code -= bound
+        d.rng -= bound
+        *p -= *p >> 5
+        b = 1
+    }
+    d.normalize()
+    return b
+}
+
+func (d *rangeDecoder) direct(n uint) uint32 {
+    var v uint32
+    for ; n > 0; n-- {
+        d.rng >>= 1
+        d.code -= d.rng
+        t := 0 - (d.code >> 31)
+        d.code += d.rng & t
+        if d.code == d.rng {
+            d.err = errLZMA
+        }
+        d.normalize()
+        v = v<<1 + t + 1
+    }
+    return v
+}
+
+func (d *rangeDecoder) tree(p []prob, bits uint) uint32 {
+    m := uint32(1)
+    for i := uint(0); i < bits; i++ {
+        m = m<<1 | uint32(d.bit(&p[m]))
+    }
+    return m - 1<<bits
+}
+
+func (d *rangeDecoder) reverseTree(p []prob, bits uint) uint32 {
+    m, v := uint32(1), uint32(0)
+    for i := uint(0); i < bits; i++ {
+        b := uint32(d.bit(&p[m]))
+        m = m<<1 | b
+        v |= b << i
+    }
+    return v
+}
+
+func (d *rangeDecoder) length(m *lzmaLenModel, posState int) int {
+    if d.bit(&m.choice) == 0 {
+        return int(d.tree(m.low[posState][:], 3)) + lzmaMinMatch
+    }
+    if d.bit(&m.choice2) == 0 {
+        return int(d.tree(m.mid[posState][:], 3)) + lzmaMinMatch + 8
+    }
+    return int(d.tree(m.high[:], 8)) + lzmaMinMatch + 16
+}
+
+// lzmaReader decompresses the LZMA data of a zip entry.
+type lzmaReader struct {
+    rd   rangeDecoder
+    m    *lzmaModel
+    dict int    // dictionary size
+    hist []byte // decoded output; the last dict bytes are the window
+    rpos int
+    pos  int64 // total bytes decoded
+    err  error
+    peek interface{ Peek(int) ([]byte, error) }
+}
+
+// newLZMAReader returns a reader decompressing a zip LZMA entry. The data
+// ends with an end of stream marker, or when the input is exhausted; with
+// an io.ByteReader that cannot peek ahead, the marker is required.
+func newLZMAReader(r io.Reader) io.ReadCloser {
+    br, ok := r.(io.ByteReader)
+    if !ok {
+        br = bufio.NewReader(r)
+    }
+    z := &lzmaReader{rd: rangeDecoder{br: br}}
+    z.peek, _ = br.(interface{ Peek(int) ([]byte, error) })
+    z.err = z.header()
+    return z
+}
+
+func (z *lzmaReader) header() error {
+    var hdr [9]byte
+    for i := range hdr {
+        b, err := z.rd.br.ReadByte()
+        if err != nil {
+            return unexpected(err)
+        }
+        hdr[i] = b
+    }
+    if binary.LittleEndian.Uint16(hdr[2:]) != 5 {
+        return errLZMA
+    }
+    d := uint(hdr[4])
+    if d >= 9*5*5 {
+        return errLZMA
+    }
+    lc, lp, pb := d%9, d/9%5, d/45
+    z.m = newLZMAModel(lc, lp, pb)
+    z.dict = int(binary.LittleEndian.Uint32(hdr[5:]))
+    if z.dict < 1<<12 {
+        z.dict = 1 << 12
+    }
+    return z.rd.init()
+}
+
+func (z *lzmaReader) Read(p []byte) (int, error) {
+    for z.rpos == len(z.hist) {
+        if z.err != nil {
+            return 0, z.err
+        }
+        if len(z.hist) >= 2*z.dict+2*lzmaMaxMatch && len(z.hist) > 1<<20 {
+            n := copy(z.hist, z.hist[len(z.hist)-z.dict:])
+            z.hist = z.hist[:n]
+            z.rpos = n
+        }
+        z.err = z.step()
+    }
+    n := copy(p, z.hist[z.rpos:])
+    z.rpos += n
+    return n, nil
+}
+
+func (z *lzmaReader) Close() error { return nil }
+
+// step decodes symbols until some output is available.
+func (z *lzmaReader) step() error {
+    m, rd := z.m, &z.rd
+    for n := 0; n < 4096; n++ {
+        if z.peek != nil && rd.code == 0 {
+            // Without an end marker, the data ends with the input.
+            if _, err := z.peek.Peek(1); err == io.EOF {
+                return io.EOF
+            }
+        }
+        posState := int(z.pos) & (1<<m.pb - 1)
+        if rd.bit(&m.isMatch[m.state<<lzmaPosBitsMax+posState]) == 0 {
+            z.literal()
+        } else if err := z.match(posState); err != nil {
+            return err
+        }
+        if rd.err != nil {
+            return rd.err
+        }
+    }
+    return nil
+}
+
+func (z *lzmaReader) byteAt(dist uint32) byte {
+    return z.hist[len(z.hist)-1-int(dist)]
+}
+
+func (z *lzmaReader) literal() {
+    m, rd := z.m, &z.rd
+    var prev byte
+    if len(z.hist) > 0 {
+        prev = z.hist[len(z.hist)-1]
+    }
+    probs := m.literalProbs(z.pos, prev)
+    sym := uint32(1)
+    if m.state >= 7 && int64(m.reps[0]) < z.pos {
+        match := uint32(z.byteAt(m.reps[0]))
+        for sym < 0x100 {
+            mbit := (match >> 7) & 1
+            match <<= 1
+            b := uint32(rd.bit(&probs[(1+mbit)<<8+sym]))
+            sym = sym<<1 | b
+            if mbit != b {
+                break
+            }
+        }
+    }
+    for sym < 0x100 {
+        sym = sym<<1 | uint32(rd.bit(&probs[sym]))
+    }
+    z.hist = append(z.hist, byte(sym))
+    z.pos++
+    m.stateLiteral()
+}
+
+func (z *lzmaReader) match(posState int) error {
+    m, rd := z.m, &z.rd
+    var length int
+    if rd.bit(&m.isRep[m.state]) != 0 {
+        if z.pos == 0 {
+            return errLZMA
+        }
+        if rd.bit(&m.isRepG0[m.state]) == 0 {
+            if rd.bit(&m.isRep0Long[m.state<<lzmaPosBitsMax+posState]) == 0 {
+                m.stateShortRep()
+                z.hist = append(z.hist, z.byteAt(m.reps[0]))
+                z.pos++
+                return nil
+            }
+        } else {
+            var dist uint32
+            if rd.bit(&m.isRepG1[m.state]) == 0 {
+                dist = m.reps[1]
+            } else {
+                if rd.bit(&m.isRepG2[m.state]) == 0 {
+                    dist = m.reps[2]
+                } else {
+                    dist = m.reps[3]
+                    m.reps[3] = m.reps[2]
+                }
+                m.reps[2] = m.reps[1]
+            }
+            m.reps[1] = m.reps[0]
+            m.reps[0] = dist
+        }
+        length = rd.length(&m.repLen, posState)
+        m.stateRep()
+    } else {
+        m.reps[3], m.reps[2], m.reps[1] = m.reps[2], m.reps[1], m.reps[0]
+        length = rd.length(&m.matchLen, posState)
+        m.stateMatch()
+        dist := z.distance(length)
+        if dist == 0xffffffff {
+            if rd.code != 0 {
+                return errLZMA
+            }
+            return io.EOF
+        }
+        m.reps[0] = dist
+    }
+    if int64(m.reps[0]) >= z.pos || int(m.reps[0]) >= z.dict {
+        return errLZMA
+    }
+    for ; length > 0; length-- {
+        z.hist = append(z.hist, z.byteAt(m.reps[0]))
+        z.pos++
+    }
+    return nil
+}
+
+func (z *lzmaReader) distance(length int) uint32 {
+    m, rd := z.m, &z.rd
+    slot := rd.tree(m.posSlot[lenState(length)][:], 6)
+    if slot < 4 {
+        return slot
+    }
+    bits := uint(slot>>1) - 1
+    dist := (2 | slot&1) << bits
+    if slot < lzmaEndPosModel {
+        return dist + rd.reverseTree(m.posSpecial[dist-slot:], bits)
+    }
+    dist += rd.direct(bits-lzmaAlignBits) << lzmaAlignBits
+    return dist + rd.reverseTree(m.align[:], lzmaAlignBits)
+}
+
+// rangeEncoder produces the LZMA range coded bit stream.
+type rangeEncoder struct {
+    w         io.Writer
+    low       uint64
+    rng       uint32
+    cache     byte
+    cacheSize int64
+    buf       []byte
+    err       error
+}
+
+func (e *rangeEncoder) shiftLow() {
+    if uint32(e.low) < 0xff000000 || e.low>>32 != 0 {
+        carry := byte(e.low >> 32)
+        temp := e.cache
+        for {
+            e.buf = append(e.buf, temp+carry)
+            temp = 0xff
+            if e.cacheSize--; e.cacheSize == 0 {
+                break
+            }
+        }
+        e.cache = byte(uint32(e.low) >> 24)
+    }
+    e.cacheSize++
+    e.low = uint64(uint32(e.low) << 8)
+    if len(e.buf) >= 32<<10 {
+        e.flushBuf()
+    }
+}
+
+func (e *rangeEncoder) flushBuf() {
+    if e.err == nil {
+        _, e.err = e.w.Write(e.buf)
+    }
+    e.buf = e.buf[:0]
+}
+
+func (e *rangeEncoder) bit(p *prob, b int) {
+    bound := (e.rng >> 11) * uint32(*p)
+    if b == 0 {
+        e.rng = bound
+        *p += (1<<11 - *p) >> 5
+    } else {
+        e.low += uint64(bound)
+        e.rng -= bound
+        *p -= *p >> 5
+    }
+    for e.rng < 1<<24 {
+        e.rng <<= 8
+        e.shiftLow()
+    }
+}
+
+func (e *rangeEncoder) direct(v uint32, n uint) {
+    for n > 0 {
+        n--
+        e.rng >>= 1
+        if v>>n&1 != 0 {
+            e.low += uint64(e.rng)
+        }
+        for e.rng < 1<<24 {
+            e.rng <<= 8
+            e.shiftLow()
+        }
+    }
+}
+
+func (e *rangeEncoder) tree(p []prob, bits uint, v uint32) {
+    m := uint32(1)
+    for i := bits; i > 0; i-- {
+        b := v >> (i - 1) & 1
+        e.bit(&p[m], int(b))
+        m = m<<1 | b
+    }
+}
+
+func (e *rangeEncoder) reverseTree(p []prob, bits uint, v uint32) {
+    m := uint32(1)
+    for i := uint(0); i < bits; i++ {
+        b := v & 1
+        v >>= 1
+        e.bit(&p[m], int(b))
+        m = m<<1 | b
+    }
+}
+
+func (e *rangeEncoder) length(m *lzmaLenModel, posState, length int) {
+    length -= lzmaMinMatch
+    switch {
+    case length < 8:
+        e.bit(&m.choice, 0)
+        e.tree(m.low[posState][:], 3, uint32(length))
+    case length < 16:
+        e.bit(&m.choice, 1)
+        e.bit(&m.choice2, 0)
+        e.tree(m.mid[posState][:], 3, uint32(length-8))
+    default:
+        e.bit(&m.choice, 1)
+        e.bit(&m.choice2, 1)
+        e.tree(m.high[:], 8, uint32(length-16))
+    }
+}
+
+func (e *rangeEncoder) flush() {
+    for i := 0; i < 5; i++ {
+        e.shiftLow()
+    }
+    e.flushBuf()
+}
+
+// lzmaWriter compresses to the zip LZMA format. The data ends with an end
+// of stream marker, so entries written with it should set general purpose
+// flag bit 1.
+type lzmaWriter struct {
+    re  rangeEncoder
+    m   *lzmaModel
+    mf  *matcher
+    pos int   // next position of mf.buf to encode
+    n   int64 // bytes encoded
+}
+
+func newLZMAWriter(w io.Writer) (io.WriteCloser, error) {
+    z := &lzmaWriter{
+        re: rangeEncoder{w: w, rng: 0xffffffff, cacheSize: 1},
+        m:  newLZMAModel(lzmaLC, lzmaLP, lzmaPB),
+        mf: newMatcher(lzmaDictSize, lzmaMaxMatch, 48),
+    }
+    hdr := make([]byte, 9)
+    hdr[0], hdr[1] = 9, 20 // LZMA SDK version
+    binary.LittleEndian.PutUint16(hdr[2:], 5)
+    hdr[4] = (lzmaPB*5+lzmaLP)*9 + lzmaLC
+    binary.LittleEndian.PutUint32(hdr[5:], lzmaDictSize)
+    z.re.buf = hdr
+    return z, nil
+}
+
+func (z *lzmaWriter) Write(p []byte) (int, error) {
+    if z.re.err != nil {
+        return 0, z.re.err
+    }
+    n := len(p)
+    for len(p) > 0 {
+        chunk := p
+        if len(chunk) > 1<<16 {
+            chunk = chunk[:1<<16]
+        }
+        p = p[len(chunk):]
+        z.mf.write(chunk)
+        z.encode(lzmaMaxMatch)
+    }
+    return n, z.re.err
+}
+
+func (z *lzmaWriter) Close() error {
+    z.encode(0)
+    // End of stream marker: a match with distance 0xffffffff.
+    m, re := z.m, &z.re
+    posState := int(z.n) & (1<<m.pb - 1)
+    re.bit(&m.isMatch[m.state<<lzmaPosBitsMax+posState], 1)
+    re.bit(&m.isRep[m.state], 0)
+    re.length(&m.matchLen, posState, lzmaMinMatch)
+    z.distance(0xffffffff, lzmaMinMatch)
+    re.flush()
+    return re.err
+}
+
+// encode codes the buffered data except for the last keep bytes, taking
+// repeated distances, then the longest match, then a literal.
+func (z *lzmaWriter) encode(keep int) {
+    mf, m := z.mf, z.m
+    for z.pos < len(mf.buf)-keep {
+        mf.insert(z.pos)
+        avail := len(mf.buf) - z.pos
+        if avail > lzmaMaxMatch {
+            avail = lzmaMaxMatch
+        }
+        repLen, repIdx := 0, 0
+        for i, r := range m.reps {
+            d := int(r) + 1
+            if int64(d) > z.n || d > z.pos {
+                continue
+            }
+            l := 0
+            for l < avail && mf.buf[z.pos+l] == mf.buf[z.pos+l-d] {
+                l++
+            }
+            if l > repLen {
+                repLen, repIdx = l, i
+            }
+        }
+        length, dist := mf.find(z.pos, z.pos+avail)
+        switch {
+        case repLen >= lzmaMinMatch && repLen+1 >= length:
+            z.rep(repIdx, repLen)
+            length = repLen
+        case length >= lzmaMinMatch && !(length == 3 && dist > 1<<14) && !mf.deferMatch(z.pos, z.pos+avail, length):
+            z.match(uint32(dist-1), length)
+        case z.n > 0 && z.pos > int(m.reps[0]) && mf.buf[z.pos] == mf.buf[z.pos-int(m.reps[0])-1]:
+            z.shortRep()
+            length = 1
+        default:
+            z.literal()
+            length = 1
+        }
+        z.pos += length
+        z.n += int64(length)
+        if n := mf.slide(z.pos); n > 0 {
+            z.pos -= n
+        }
+    }
+}
+
+func (z *lzmaWriter) literal() {
+    m, re, buf := z.m, &z.re, z.mf.buf
+    posState := int(z.n) & (1<<m.pb - 1)
+    re.bit(&m.isMatch[m.state<<lzmaPosBitsMax+posState], 0)
+    var prev byte
+    if z.n > 0 {
+        prev = buf[z.pos-1]
+    }
+    probs := m.literalProbs(z.n, prev)
+    sym := uint32(buf[z.pos]) | 0x100
+    ctx := uint32(1)
+    if m.state >= 7 {
+        match := uint32(buf[z.pos-int(m.reps[0])-1])
+        for i := 7; i >= 0; i-- {
+            b := sym >> uint(i) & 1
+            mbit := match >> uint(i) & 1
+            re.bit(&probs[(1+mbit)<<8+ctx], int(b))
+            ctx = ctx<<1 | b
+            if mbit != b {
+                for i--; i >= 0; i-- {
+                    b := sym >> uint(i) & 1
+                    re.bit(&probs[ctx], int(b))
+                    ctx = ctx<<1 | b
+                }
+                break
+            }
+        }
+    } else {
+        re.tree(probs, 8, sym&0xff)
+    }
+    m.stateLiteral()
+}
+
+func (z *lzmaWriter) shortRep() {
+    m, re := z.m, &z.re
+    posState := int(z.n) & (1<<m.pb - 1)
+    re.bit(&m.isMatch[m.state<<lzmaPosBitsMax+posState], 1)
+    re.bit(&m.isRep[m.state], 1)
+    re.bit(&m.isRepG0[m.state], 0)
+    re.bit(&m.isRep0Long[m.state<<lzmaPosBitsMax+posState], 0)
+    m.stateShortRep()
+}
+
+func (z *lzmaWriter) rep(idx, length int) {
+    m, re := z.m, &z.re
+    posState := int(z.n) & (1<<m.pb - 1)
+    re.bit(&m.isMatch[m.state<<lzmaPosBitsMax+posState], 1)
+    re.bit(&m.isRep[m.state], 1)
+    if idx == 0 {
+        re.bit(&m.isRepG0[m.state], 0)
+        re.bit(&m.isRep0Long[m.state<<lzmaPosBitsMax+posState], 1)
+    } else {
+        re.bit(&m.isRepG0[m.state], 1)
+        dist := m.reps[idx]
+        if idx == 1 {
+            re.bit(&m.isRepG1[m.state], 0)
+        } else {
+            re.bit(&m.isRepG1[m.state], 1)
+            re.bit(&m.isRepG2[m.state], idx-2)
+            if idx == 3 {
+                m.reps[3] = m.reps[2]
+            }
+            m.reps[2] = m.reps[1]
+        }
+        m.reps[1] = m.reps[0]
+        m.reps[0] = dist
+    }
+    re.length(&m.repLen, posState, length)
+    m.stateRep()
+}
+
+func (z *lzmaWriter) match(dist uint32, length int) {
+    m, re := z.m, &z.re
+    posState := int(z.n) & (1<<m.pb - 1)
+    re.bit(&m.isMatch[m.state<<lzmaPosBitsMax+posState], 1)
+    re.bit(&m.isRep[m.state], 0)
+    re.length(&m.matchLen, posState, length)
+    z.distance(dist, length)
+    m.reps[3], m.reps[2], m.reps[1], m.reps[0] = m.reps[2], m.reps[1], m.reps[0], dist
+    m.stateMatch()
+}
+
+func (z *lzmaWriter) distance(dist uint32, length int) {
+    m, re := z.m, &z.re
+    slot := lzmaPosSlot(dist)
+    re.tree(m.posSlot[lenState(length)][:], 6, slot)
+    if slot < 4 {
+        return
+    }
+    bits := uint(slot>>1) - 1
+    base := (2 | slot&1) << bits
+    if slot < lzmaEndPosModel {
+        re.reverseTree(m.posSpecial[base-slot:], bits, dist-base)
+        return
+    }
+    re.direct((dist-base)>>lzmaAlignBits, bits-lzmaAlignBits)
+    re.reverseTree(m.align[:], lzmaAlignBits, dist&(1<<lzmaAlignBits-1))
+}
+
+// lzmaPosSlot returns the slot of a distance: its two leading bits and
+// their position.
+func lzmaPosSlot(dist uint32) uint32 {
+    if dist < 4 {
+        return dist
+    }
+    n := uint32(31)
+    for dist>>n == 0 {
+        n--
+    }
+    return n<<1 | dist>>(n-1)&1
+}
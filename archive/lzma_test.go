@@ -0,0 +1,52 @@
+package archive
+
+import (
+    "bytes"
+    "io/ioutil"
+    "testing"
+)
+
+func TestLZMARoundTrip(t *testing.T) {
+    roundTrip(t, newLZMAWriter, newLZMAReader)
+}
+
+func TestLZMAStandard(t *testing.T) {
+    // testdata/gopher.lzma was written by xz in the .lzma format, whose
+    // header is the properties followed by the uncompressed size.
+    data, err := ioutil.ReadFile("testdata/gopher.lzma")
+    if err != nil {
+        t.Fatal(err)
+    }
+    want, err := ioutil.ReadFile("testdata/gopher.txt")
+    if err != nil {
+        t.Fatal(err)
+    }
+    zipData := append([]byte{9, 20, 5, 0}, data[:5]...)
+    zipData = append(zipData, data[13:]...)
+    got, err := ioutil.ReadAll(newLZMAReader(bytes.NewReader(zipData)))
+    if err != nil {
+        t.Fatal(err)
+    }
+    if !bytes.Equal(got, want) {
+        t.Fatalf("got %q", got)
+    }
+}
+
+func TestLZMAWithoutEndMarker(t *testing.T) {
+    // Entries of known size may omit the end marker; the data then ends
+    // with the input.
+    in := compressInputs()["text"]
+    var buf bytes.Buffer
+    w, _ := newLZMAWriter(&buf)
+    z := w.(*lzmaWriter)
+    z.Write(in)
+    z.encode(0)
+    z.re.flush()
+    got, err := ioutil.ReadAll(newLZMAReader(&buf))
+    if err != nil {
+        t.Fatal(err)
+    }
+    if !bytes.Equal(got, in) {
+        t.Fatalf("got %d bytes, want %d", len(got), len(in))
+    }
+}
@@ -0,0 +1,502 @@
+package archive
+
+import (
+    "archive/tar"
+    "bufio"
+    "crypto/sha256"
+    "encoding/json"
+    "errors"
+    "fmt"
+    "hash"
+    "io"
+    "io/ioutil"
+    "os"
+    "path/filepath"
+    "strconv"
+    "strings"
+    "time"
+)
+
+// Manifest describes the entries of an archive in a form meant to be kept
+// as JSON and reviewed. ExportManifest produces one from an archive, and
+// BuildManifest produces an archive from one.
+type Manifest struct {
+    Comment string          `json:"comment,omitempty"`
+    Entries []ManifestEntry `json:"entries"`
+}
+
+// ManifestEntry describes one entry. When building, the content comes from
+// at most one of Source, Content or Archive, and every metadata field that
+// is set overrides what the content's origin says.
+type ManifestEntry struct {
+    Name string `json:"name"`
+    // Type is one of file, dir, symlink, hardlink, char, block and fifo.
+    // Empty means the type of the source, or file.
+    Type  string     `json:"type,omitempty"`
+    Mode  string     `json:"mode,omitempty"` // octal permission bits, such as "0644"
+    Size  *int64     `json:"size,omitempty"`
+    MTime *time.Time `json:"mtime,omitempty"`
+    UID   *int       `json:"uid,omitempty"`
+    GID   *int       `json:"gid,omitempty"`
+    User  string     `json:"user,omitempty"`
+    Group string     `json:"group,omitempty"`
+    Link  string     `json:"link,omitempty"` // target of links
+    // DevMajor and DevMinor are the numbers of device entries.
+    DevMajor int64 `json:"devmajor,omitempty"`
+    DevMinor int64 `json:"devminor,omitempty"`
+    // Hash is the "sha256:" digest of the content of files. When
+    // building it is checked, as is Size.
+    Hash string `json:"hash,omitempty"`
+
+    // Source is a file on disk, relative to the manifest's directory
+    // unless absolute. A directory is added without its content.
+    Source string `json:"source,omitempty"`
+    // Content is the literal content of a file.
+    Content *string `json:"content,omitempty"`
+    // Archive and Member name an entry of another archive, whose path is
+    // resolved like Source.
+    Archive string `json:"archive,omitempty"`
+    Member  string `json:"member,omitempty"`
+}
+
+// manifestTypes maps tar typeflags to manifest type names.
+var manifestTypes = map[byte]string{
+    tar.TypeReg:     "file",
+    tar.TypeDir:     "dir",
+    tar.TypeSymlink: "symlink",
+    tar.TypeLink:    "hardlink",
+    tar.TypeChar:    "char",
+    tar.TypeBlock:   "block",
+    tar.TypeFifo:    "fifo",
+}
+
+// manifestTypeflag returns the tar typeflag of a manifest type name.
+func manifestTypeflag(typ string) (byte, bool) {
+    for flag, name := range manifestTypes {
+        if name == typ {
+            return flag, true
+        }
+    }
+    return 0, false
+}
+
+// ReadManifest decodes a JSON manifest. Unknown fields are rejected, so
+// misspelt overrides are not silently ignored.
+func ReadManifest(r io.Reader) (*Manifest, error) {
+    dec := json.NewDecoder(r)
+    dec.DisallowUnknownFields()
+    m := &Manifest{}
+    if err := dec.Decode(m); err != nil {
+        return nil, fmt.Errorf("archive: manifest: %w", err)
+    }
+    return m, nil
+}
+
+// WriteManifest encodes m as indented JSON.
+func WriteManifest(w io.Writer, m *Manifest) error {
+    b, err := json.MarshalIndent(m, "", "  ")
+    if err != nil {
+        return err
+    }
+    _, err = w.Write(append(b, '\n'))
+    return err
+}
+
+// ExportManifest describes the archive in r: a zip archive, or a tar, ar
+// or cpio archive, which may be gzip, bzip2 or zstd compressed. The
+// content of every file is read to compute its hash.
+func ExportManifest(r io.ReaderAt, size int64) (*Manifest, error) {
+    if DetectFormat(r) == FormatZip {
+        return exportZipManifest(r, size)
+    }
+    er, err := openSequential(r, size)
+    if err != nil {
+        return nil, err
+    }
+    m := &Manifest{}
+    for {
+        hdr, err := er.Next()
+        if err == io.EOF {
+            return m, nil
+        }
+        if err != nil {
+            return nil, err
+        }
+        if hdr.Typeflag == tar.TypeXGlobalHeader {
+            m.Comment = hdr.PAXRecords["comment"]
+            continue
+        }
+        e, err := manifestEntry(hdr, true, func() (io.ReadCloser, error) { return ioutil.NopCloser(er), nil })
+        if err != nil {
+            return nil, err
+        }
+        m.Entries = append(m.Entries, e)
+    }
+}
+
+// ExportManifestFile describes the named archive file.
+func ExportManifestFile(name string) (*Manifest, error) {
+    f, err := os.Open(name)
+    if err != nil {
+        return nil, err
+    }
+    defer f.Close()
+    fi, err := f.Stat()
+    if err != nil {
+        return nil, err
+    }
+    return ExportManifest(f, fi.Size())
+}
+
+func exportZipManifest(r io.ReaderAt, size int64) (*Manifest, error) {
+    model, err := loadZipModel(r, size)
+    if err != nil {
+        return nil, err
+    }
+    m := &Manifest{Comment: model.Comment}
+    dict := model.usesDictionary()
+    for _, me := range model.entries {
+        if dict && me.hdr.Name == DictionaryName {
+            continue
+        }
+        // Zip records no owners.
+        e, err := manifestEntry(me.hdr, false, me.Open)
+        if err != nil {
+            return nil, err
+        }
+        m.Entries = append(m.Entries, e)
+    }
+    return m, nil
+}
+
+// manifestEntry describes the entry hdr, hashing the content of files read
+// through open.
+func manifestEntry(hdr *tar.Header, owners bool, open func() (io.ReadCloser, error)) (ManifestEntry, error) {
+    typeflag := hdr.Typeflag
+    switch typeflag {
+    case tar.TypeRegA, tar.TypeGNUSparse:
+        typeflag = tar.TypeReg
+    case TypeGNUDumpdir:
+        typeflag = tar.TypeDir
+    }
+    typ, ok := manifestTypes[typeflag]
+    if !ok {
+        typ = string(typeflag)
+    }
+    mtime := hdr.ModTime.UTC()
+    e := ManifestEntry{
+        Name:     hdr.Name,
+        Type:     typ,
+        Mode:     fmt.Sprintf("%04o", hdr.Mode&07777),
+        MTime:    &mtime,
+        Link:     hdr.Linkname,
+        DevMajor: hdr.Devmajor,
+        DevMinor: hdr.Devminor,
+    }
+    if owners {
+        uid, gid := hdr.Uid, hdr.Gid
+        e.UID, e.GID = &uid, &gid
+        e.User, e.Group = hdr.Uname, hdr.Gname
+    }
+    if typeflag != tar.TypeReg && !(typeflag == tar.TypeSymlink && e.Link == "") {
+        return e, nil
+    }
+    rc, err := open()
+    if err != nil {
+        return e, &EntryError{Name: hdr.Name, Err: err}
+    }
+    defer rc.Close()
+    if typeflag == tar.TypeSymlink {
+        // Zip archives read as a stream keep symlink targets as content.
+        link, err := ioutil.ReadAll(io.LimitReader(rc, 4096))
+        if err != nil {
+            return e, &EntryError{Name: hdr.Name, Err: err}
+        }
+        e.Link = string(link)
+        return e, nil
+    }
+    h := sha256.New()
+    n, err := copyBuffer(h, rc)
+    if err != nil {
+        return e, &EntryError{Name: hdr.Name, Err: err}
+    }
+    e.Size, e.Hash = &n, digestOf(h)
+    return e, nil
+}
+
+// BuildManifest writes the archive described by m to w in the given
+// format, tar or zip. Relative source paths are resolved against dir.
+func BuildManifest(w io.Writer, m *Manifest, dir string, format Format) error {
+    b := &manifestBuilder{dir: dir, archives: map[string]*Model{}}
+    defer b.close()
+    model := &Model{Comment: m.Comment}
+    for i := range m.Entries {
+        e, err := b.entry(&m.Entries[i])
+        if err != nil {
+            return err
+        }
+        model.entries = append(model.entries, e)
+    }
+    return model.Save(w, format)
+}
+
+// manifestBuilder resolves manifest entries to model entries.
+type manifestBuilder struct {
+    dir      string
+    archives map[string]*Model
+    files    []*os.File
+}
+
+func (b *manifestBuilder) close() {
+    for _, f := range b.files {
+        f.Close()
+    }
+}
+
+func (b *manifestBuilder) path(name string) string {
+    if filepath.IsAbs(name) {
+        return name
+    }
+    return filepath.Join(b.dir, filepath.FromSlash(name))
+}
+
+func (b *manifestBuilder) entry(me *ManifestEntry) (*ModelEntry, error) {
+    if _, err := cleanName(me.Name); err != nil || me.Name == "" {
+        if err == nil {
+            err = errors.New("empty name")
+        }
+        return nil, &EntryError{Name: me.Name, Err: err}
+    }
+    sources := 0
+    for _, set := range []bool{me.Source != "", me.Content != nil, me.Archive != ""} {
+        if set {
+            sources++
+        }
+    }
+    if sources > 1 {
+        return nil, &EntryError{Name: me.Name, Err: errors.New("more than one of source, content and archive")}
+    }
+    e, err := b.origin(me)
+    if err != nil {
+        return nil, &EntryError{Name: me.Name, Err: err}
+    }
+    if err := applyManifest(e.hdr, me, sources > 0); err != nil {
+        return nil, &EntryError{Name: me.Name, Err: err}
+    }
+    if e.hdr.Typeflag != tar.TypeReg {
+        e.open = nil
+    } else if me.Hash != "" {
+        // Checking the hash needs the content decompressed.
+        e.zf = nil
+        open := e.open
+        e.open = func() (io.ReadCloser, error) {
+            rc, err := open()
+            if err != nil {
+                return nil, err
+            }
+            return &hashCheckReader{rc: rc, h: sha256.New(), want: me.Hash}, nil
+        }
+    }
+    return e, nil
+}
+
+// origin returns an entry with the header and content of the origin of
+// me, or a header for an empty file if it has none.
+func (b *manifestBuilder) origin(me *ManifestEntry) (*ModelEntry, error) {
+    switch {
+    case me.Source != "":
+        name := b.path(me.Source)
+        fi, err := os.Lstat(name)
+        if err != nil {
+            return nil, err
+        }
+        var link string
+        if fi.Mode()&os.ModeSymlink != 0 {
+            if link, err = os.Readlink(name); err != nil {
+                return nil, err
+            }
+        }
+        hdr, err := tar.FileInfoHeader(fi, link)
+        if err != nil {
+            return nil, err
+        }
+        e := &ModelEntry{hdr: hdr}
+        if fi.Mode().IsRegular() {
+            e.open = func() (io.ReadCloser, error) { return os.Open(name) }
+        }
+        return e, nil
+    case me.Content != nil:
+        data := *me.Content
+        hdr := &tar.Header{Typeflag: tar.TypeReg, Mode: 0644, Size: int64(len(data))}
+        return &ModelEntry{hdr: hdr, open: func() (io.ReadCloser, error) {
+            return ioutil.NopCloser(strings.NewReader(data)), nil
+        }}, nil
+    case me.Archive != "":
+        if me.Member == "" {
+            return nil, errors.New("archive without member")
+        }
+        src, err := b.archive(me.Archive)
+        if err != nil {
+            return nil, err
+        }
+        e := src.Entry(me.Member)
+        if e == nil {
+            return nil, fmt.Errorf("%s: %s: %w", me.Archive, me.Member, os.ErrNotExist)
+        }
+        c := *e
+        c.hdr = e.Header()
+        return &c, nil
+    }
+    return &ModelEntry{hdr: &tar.Header{Typeflag: tar.TypeReg, Mode: 0644}}, nil
+}
+
+// archive loads the named archive once per build. Tar and zip archives
+// are read in place; other formats are read into memory.
+func (b *manifestBuilder) archive(name string) (*Model, error) {
+    if m, ok := b.archives[name]; ok {
+        return m, nil
+    }
+    m, f, err := loadModelFile(b.path(name))
+    if f != nil {
+        b.files = append(b.files, f)
+    }
+    if err != nil {
+        return nil, fmt.Errorf("%s: %w", name, err)
+    }
+    b.archives[name] = m
+    return m, nil
+}
+
+// openSequential returns an EntryReader for a tar, ar or cpio archive, or
+// a zip archive read as a stream, possibly compressed.
+func openSequential(r io.ReaderAt, size int64) (EntryReader, error) {
+    br := bufio.NewReaderSize(io.NewSectionReader(r, 0, size), 64<<10)
+    head, _ := br.Peek(512)
+    dr, err := decompressLayer(br, head)
+    if err != nil {
+        return nil, err
+    }
+    if dr != nil {
+        br = bufio.NewReaderSize(dr, 64<<10)
+        head, _ = br.Peek(512)
+    }
+    er := sequentialEntries(br, head)
+    if er == nil {
+        return nil, ErrFormat
+    }
+    return er, nil
+}
+
+// loadSequentialModel loads a possibly compressed tar, ar or cpio archive
+// into memory.
+func loadSequentialModel(r io.ReaderAt, size int64) (*Model, error) {
+    er, err := openSequential(r, size)
+    if err != nil {
+        return nil, err
+    }
+    m := &Model{}
+    for {
+        hdr, err := er.Next()
+        if err == io.EOF {
+            return m, nil
+        }
+        if err != nil {
+            return nil, err
+        }
+        var content []byte
+        if hdr.Typeflag == tar.TypeReg || hdr.Typeflag == tar.TypeRegA {
+            if content, err = ioutil.ReadAll(er); err != nil {
+                return nil, &EntryError{Name: hdr.Name, Err: err}
+            }
+        }
+        if err := m.Add(hdr, content); err != nil {
+            return nil, err
+        }
+    }
+}
+
+// applyManifest overrides the fields of hdr that me sets. hasOrigin
+// reports whether hdr came from a source rather than being a default.
+func applyManifest(hdr *tar.Header, me *ManifestEntry, hasOrigin bool) error {
+    hdr.Name = me.Name
+    if hdr.Typeflag == tar.TypeRegA {
+        hdr.Typeflag = tar.TypeReg
+    }
+    if me.Type != "" {
+        flag, ok := manifestTypeflag(me.Type)
+        if !ok {
+            return fmt.Errorf("unknown type %q", me.Type)
+        }
+        if hasOrigin && flag != hdr.Typeflag {
+            return fmt.Errorf("type %s does not match the %s of its source", me.Type, manifestTypes[hdr.Typeflag])
+        }
+        if !hasOrigin {
+            hdr.Typeflag = flag
+            if flag == tar.TypeDir {
+                hdr.Mode = 0755
+            }
+        }
+    }
+    if me.Mode != "" {
+        mode, err := strconv.ParseUint(me.Mode, 8, 12)
+        if err != nil {
+            return fmt.Errorf("bad mode %q", me.Mode)
+        }
+        hdr.Mode = int64(mode)
+    }
+    if me.MTime != nil {
+        hdr.ModTime = *me.MTime
+    }
+    if me.UID != nil {
+        hdr.Uid = *me.UID
+    }
+    if me.GID != nil {
+        hdr.Gid = *me.GID
+    }
+    if me.User != "" {
+        hdr.Uname = me.User
+    }
+    if me.Group != "" {
+        hdr.Gname = me.Group
+    }
+    if me.Link != "" {
+        hdr.Linkname = me.Link
+    }
+    if me.DevMajor != 0 || me.DevMinor != 0 {
+        hdr.Devmajor, hdr.Devminor = me.DevMajor, me.DevMinor
+    }
+    switch hdr.Typeflag {
+    case tar.TypeSymlink, tar.TypeLink:
+        if hdr.Linkname == "" {
+            return errors.New("link without target")
+        }
+    case tar.TypeReg:
+        if me.Size != nil && *me.Size != hdr.Size {
+            return fmt.Errorf("size is %d, manifest says %d", hdr.Size, *me.Size)
+        }
+    default:
+        hdr.Size = 0
+    }
+    return nil
+}
+
+// hashCheckReader fails at the end of the content if its digest is not
+// the one wanted.
+type hashCheckReader struct {
+    rc   io.ReadCloser
+    h    hash.Hash
+    want string
+}
+
+func (r *hashCheckReader) Read(p []byte) (int, error) {
+    n, err := r.rc.Read(p)
+    r.h.Write(p[:n])
+    if err == io.EOF {
+        if got := digestOf(r.h); !strings.EqualFold(got, r.want) {
+            return n, fmt.Errorf("content hash is %s, manifest says %s", got, r.want)
+        }
+    }
+    return n, err
+}
+
+func (r *hashCheckReader) Close() error { return r.rc.Close() }
@@ -0,0 +1,158 @@
+package archive
+
+import (
+    "bytes"
+    "context"
+    "crypto/sha256"
+    "fmt"
+    "io/ioutil"
+    "path/filepath"
+    "reflect"
+    "strings"
+    "testing"
+    "time"
+)
+
+func sha256Digest(s string) string {
+    return fmt.Sprintf("sha256:%x", sha256.Sum256([]byte(s)))
+}
+
+func manifestByName(m *Manifest) map[string]ManifestEntry {
+    byName := map[string]ManifestEntry{}
+    for _, e := range m.Entries {
+        byName[e.Name] = e
+    }
+    return byName
+}
+
+func TestExportManifest(t *testing.T) {
+    src := tempDir(t)
+    writeTree(t, src, treeFiles)
+    var buf bytes.Buffer
+    if err := WriteTar(context.Background(), &buf, src, nil); err != nil {
+        t.Fatal(err)
+    }
+    m, err := ExportManifest(bytes.NewReader(buf.Bytes()), int64(buf.Len()))
+    if err != nil {
+        t.Fatal(err)
+    }
+    byName := manifestByName(m)
+    for name, body := range treeFiles {
+        e, ok := byName[name]
+        if !ok {
+            t.Errorf("%s missing", name)
+            continue
+        }
+        if e.Type != "file" || e.Hash != sha256Digest(body) || *e.Size != int64(len(body)) || e.UID == nil {
+            t.Errorf("%s = %+v", name, e)
+        }
+    }
+
+    var js bytes.Buffer
+    if err := WriteManifest(&js, m); err != nil {
+        t.Fatal(err)
+    }
+    back, err := ReadManifest(&js)
+    if err != nil {
+        t.Fatal(err)
+    }
+    if !reflect.DeepEqual(back, m) {
+        t.Errorf("JSON round trip changed the manifest:\n%+v\n%+v", back, m)
+    }
+
+    gz := tarGz(t, map[string]string{"a.txt": "alpha"})
+    m, err = ExportManifest(bytes.NewReader(gz), int64(len(gz)))
+    if err != nil {
+        t.Fatal(err)
+    }
+    if len(m.Entries) != 1 || m.Entries[0].Hash != sha256Digest("alpha") {
+        t.Errorf("tar.gz manifest = %+v", m.Entries)
+    }
+}
+
+func TestBuildManifest(t *testing.T) {
+    dir := tempDir(t)
+    writeTree(t, dir, map[string]string{"bin/tool": "#!/bin/sh\n"})
+    zipped := zipBytes(t, map[string][]byte{"docs/readme": []byte("from zip")})
+    ioutil.WriteFile(filepath.Join(dir, "docs.zip"), zipped, 0644)
+    ioutil.WriteFile(filepath.Join(dir, "etc.tar.gz"), tarGz(t, map[string]string{"etc/conf": "from tgz"}), 0644)
+
+    mtime := time.Date(2020, 1, 2, 3, 4, 5, 0, time.UTC)
+    inline := "inline text"
+    uid := 1000
+    m := &Manifest{Comment: "built", Entries: []ManifestEntry{
+        {Name: "bin/", Type: "dir", MTime: &mtime},
+        {Name: "bin/tool", Source: "bin/tool", Mode: "0755", MTime: &mtime, UID: &uid, User: "build"},
+        {Name: "notes.txt", Content: &inline, Hash: sha256Digest(inline)},
+        {Name: "readme", Archive: "docs.zip", Member: "docs/readme", MTime: &mtime},
+        {Name: "conf", Archive: "etc.tar.gz", Member: "etc/conf"},
+        {Name: "latest", Type: "symlink", Link: "notes.txt"},
+    }}
+    for _, format := range []Format{FormatTar, FormatZip} {
+        var out bytes.Buffer
+        if err := BuildManifest(&out, m, dir, format); err != nil {
+            t.Fatalf("%v: %v", format, err)
+        }
+        got, err := ExportManifest(bytes.NewReader(out.Bytes()), int64(out.Len()))
+        if err != nil {
+            t.Fatalf("%v: %v", format, err)
+        }
+        if got.Comment != "built" {
+            t.Errorf("%v: comment = %q", format, got.Comment)
+        }
+        byName := manifestByName(got)
+        for name, body := range map[string]string{
+            "bin/tool":  "#!/bin/sh\n",
+            "notes.txt": inline,
+            "readme":    "from zip",
+            "conf":      "from tgz",
+        } {
+            if e := byName[name]; e.Hash != sha256Digest(body) {
+                t.Errorf("%v: %s = %+v, want content %q", format, name, e, body)
+            }
+        }
+        tool := byName["bin/tool"]
+        if tool.Mode != "0755" || !tool.MTime.Equal(mtime) {
+            t.Errorf("%v: overrides not applied: %+v", format, tool)
+        }
+        if format == FormatTar && (*tool.UID != 1000 || tool.User != "build") {
+            t.Errorf("owner not applied: %+v", tool)
+        }
+        if !byName["readme"].MTime.Equal(mtime) {
+            t.Errorf("%v: readme mtime = %v", format, byName["readme"].MTime)
+        }
+        if e := byName["latest"]; e.Type != "symlink" || e.Link != "notes.txt" {
+            t.Errorf("%v: latest = %+v", format, e)
+        }
+        if e := byName["bin/"]; e.Type != "dir" {
+            t.Errorf("%v: bin/ = %+v", format, e)
+        }
+    }
+}
+
+func TestBuildManifestErrors(t *testing.T) {
+    dir := tempDir(t)
+    writeTree(t, dir, map[string]string{"file": "content"})
+    text := "text"
+    wrong := int64(3)
+    for _, e := range []ManifestEntry{
+        {Name: "a", Content: &text, Hash: sha256Digest("other")},
+        {Name: "a", Content: &text, Size: &wrong},
+        {Name: "a", Source: "file", Type: "dir"},
+        {Name: "a", Source: "file", Content: &text},
+        {Name: "a", Source: "missing"},
+        {Name: "a", Type: "symlink"},
+        {Name: "../a", Content: &text},
+        {Name: "a", Content: &text, Mode: "rwx"},
+    } {
+        m := &Manifest{Entries: []ManifestEntry{e}}
+        if err := BuildManifest(ioutil.Discard, m, dir, FormatTar); err == nil {
+            t.Errorf("%+v: no error", e)
+        }
+    }
+
+    _, err := ReadManifest(strings.NewReader(`{"entries": [{"name": "a", "mdoe": "0644"}]}`))
+    if err == nil {
+        t.Error("unknown field accepted")
+    }
+}
@@ -0,0 +1,268 @@
+package archive
+
+import (
+    "archive/tar"
+    "errors"
+    "fmt"
+    "io"
+    "path"
+    "strings"
+)
+
+// ConflictPolicy decides between entries of merged archives that have the
+// same name. Directories present in several inputs are merged without
+// conflict.
+type ConflictPolicy int
+
+const (
+    // FirstWins keeps the entry merged first.
+    FirstWins ConflictPolicy = iota
+    // LastWins keeps the entry merged last, at the position of the first.
+    LastWins
+    // NewestWins keeps the entry modified last, or the first on a tie.
+    NewestWins
+    // ConflictError fails the merge.
+    ConflictError
+    // RenameConflicts keeps both entries, adding a numbered suffix to the
+    // name of the later one: a.txt becomes a~2.txt, then a~3.txt.
+    RenameConflicts
+)
+
+// ErrConflict is returned by Merge with the ConflictError policy, and
+// with any policy but RenameConflicts when a directory and another entry
+// have the same name.
+var ErrConflict = errors.New("archive: entries with the same name")
+
+// MergeInput is an archive to merge.
+type MergeInput struct {
+    // Path is the archive file, in any format LoadModel or the manifest
+    // builder reads.
+    Path string
+    // Remap moves entries named under a key, the longest that matches,
+    // under its value instead. The key "" matches every name, so
+    // {"": "vendor"} places the whole archive in vendor/.
+    Remap map[string]string
+}
+
+// MergeOptions configures Merge.
+type MergeOptions struct {
+    Policy ConflictPolicy
+}
+
+// MergeConflict describes two entries merged under the same name.
+type MergeConflict struct {
+    Name string
+    // Existing and Incoming are the paths of the inputs holding the entry
+    // merged first and the one that collided with it.
+    Existing, Incoming string
+    // Kept is the input whose entry has Name in the result.
+    Kept string
+    // Renamed is the name given to the incoming entry by
+    // RenameConflicts.
+    Renamed string
+}
+
+// MergeReport lists the conflicts of a merge, in the order they occurred.
+type MergeReport struct {
+    Conflicts []MergeConflict
+}
+
+// Merge combines the entries of the input archives, in order, into one
+// archive written to w in format. The report lists every conflict, also
+// when Merge fails because of one.
+func Merge(w io.Writer, inputs []MergeInput, format Format, opts *MergeOptions) (*MergeReport, error) {
+    if opts == nil {
+        opts = &MergeOptions{}
+    }
+    mg := &merger{policy: opts.Policy, out: &Model{}, index: map[string]int{}, parents: map[string]int{}, report: &MergeReport{}}
+    for _, in := range inputs {
+        m, f, err := loadModelFile(in.Path)
+        if f != nil {
+            defer f.Close()
+        }
+        if err != nil {
+            return mg.report, fmt.Errorf("%s: %w", in.Path, err)
+        }
+        if err := mg.add(in, m); err != nil {
+            return mg.report, err
+        }
+    }
+    return mg.report, mg.out.Save(w, format)
+}
+
+type merger struct {
+    policy  ConflictPolicy
+    out     *Model
+    from    []string       // the input path of each entry of out
+    index   map[string]int // entries of out by model key
+    parents map[string]int // the first entry inside each directory of out
+    report  *MergeReport
+}
+
+// add merges the entries of m, loaded from in.
+func (mg *merger) add(in MergeInput, m *Model) error {
+    dict := m.usesDictionary()
+    // moved holds the directories of in renamed by RenameConflicts, so
+    // that the entries inside follow them.
+    moved := map[string]string{}
+    for _, e := range m.entries {
+        if dict && e.hdr.Name == DictionaryName {
+            continue
+        }
+        c := *e
+        c.hdr = e.Header()
+        c.hdr.Name = remapName(remapName(e.hdr.Name, in.Remap), moved)
+        if c.hdr.Name == "" || c.hdr.Name == "/" {
+            continue // a directory mapped to the root
+        }
+        if _, err := cleanName(c.hdr.Name); err != nil {
+            return &EntryError{Name: c.hdr.Name, Err: err}
+        }
+        // An entry inside a file of the result makes the file's name a
+        // directory too.
+        if dir, i, ok := mg.fileAncestor(c.hdr.Name); ok {
+            conflict := MergeConflict{Name: dir, Existing: mg.from[i], Incoming: in.Path, Kept: mg.from[i]}
+            if err := mg.record(conflict, mg.policy == RenameConflicts); err != nil {
+                return err
+            }
+            moved[dir] = modelKey(mg.freeName(dir + "/"))
+            mg.report.Conflicts[len(mg.report.Conflicts)-1].Renamed = moved[dir] + "/"
+            c.hdr.Name = remapName(c.hdr.Name, map[string]string{dir: moved[dir]})
+        }
+        if err := mg.merge(&c, in.Path, moved); err != nil {
+            return err
+        }
+    }
+    return nil
+}
+
+// merge adds e, from the input at path from, resolving a conflict by
+// the merge policy.
+func (mg *merger) merge(e *ModelEntry, from string, moved map[string]string) error {
+    key := modelKey(e.hdr.Name)
+    newDir := e.hdr.Typeflag == tar.TypeDir
+    i, exists := mg.index[key]
+    oldDir := exists && mg.out.entries[i].hdr.Typeflag == tar.TypeDir
+    if p, ok := mg.parents[key]; ok && !exists {
+        if newDir {
+            mg.insert(e, from)
+            return nil
+        }
+        i, exists, oldDir = p, true, true
+    }
+    if !exists {
+        mg.insert(e, from)
+        return nil
+    }
+    if oldDir && newDir {
+        return nil
+    }
+    conflict := MergeConflict{Name: e.hdr.Name, Existing: mg.from[i], Incoming: from, Kept: mg.from[i]}
+    policy := mg.policy
+    if oldDir != newDir && policy != RenameConflicts {
+        // Either choice would leave entries inside a non-directory.
+        policy = ConflictError
+    }
+    switch policy {
+    case FirstWins:
+    case LastWins:
+        mg.out.entries[i], mg.from[i], conflict.Kept = e, from, from
+    case NewestWins:
+        if e.hdr.ModTime.After(mg.out.entries[i].hdr.ModTime) {
+            mg.out.entries[i], mg.from[i], conflict.Kept = e, from, from
+        }
+    case RenameConflicts:
+        e.hdr.Name = mg.freeName(e.hdr.Name)
+        if newDir {
+            moved[key] = modelKey(e.hdr.Name)
+        }
+        conflict.Renamed = e.hdr.Name
+        mg.insert(e, from)
+    }
+    return mg.record(conflict, policy != ConflictError)
+}
+
+// record reports a conflict, failing the merge unless it was resolved.
+func (mg *merger) record(c MergeConflict, resolved bool) error {
+    mg.report.Conflicts = append(mg.report.Conflicts, c)
+    if !resolved {
+        return &EntryError{Name: c.Name, Err: fmt.Errorf("%w in %s and %s", ErrConflict, c.Existing, c.Incoming)}
+    }
+    return nil
+}
+
+// fileAncestor returns the key and index of an entry of the result that
+// is not a directory but whose name is a directory of name.
+func (mg *merger) fileAncestor(name string) (string, int, bool) {
+    key := modelKey(name)
+    for j := strings.IndexByte(key, '/'); j >= 0; j = nextSlash(key, j) {
+        if i, ok := mg.index[key[:j]]; ok && mg.out.entries[i].hdr.Typeflag != tar.TypeDir {
+            return key[:j], i, true
+        }
+    }
+    return "", 0, false
+}
+
+// nextSlash returns the index of the slash in s after the one at i, or -1.
+func nextSlash(s string, i int) int {
+    if j := strings.IndexByte(s[i+1:], '/'); j >= 0 {
+        return i + 1 + j
+    }
+    return -1
+}
+
+func (mg *merger) insert(e *ModelEntry, from string) {
+    key := modelKey(e.hdr.Name)
+    mg.index[key] = len(mg.out.entries)
+    for j := strings.IndexByte(key, '/'); j >= 0; j = nextSlash(key, j) {
+        if _, ok := mg.parents[key[:j]]; !ok {
+            mg.parents[key[:j]] = len(mg.out.entries)
+        }
+    }
+    mg.out.entries = append(mg.out.entries, e)
+    mg.from = append(mg.from, from)
+}
+
+// freeName returns name with the first numbered suffix that makes it
+// unused.
+func (mg *merger) freeName(name string) string {
+    key := modelKey(name)
+    slash := strings.TrimPrefix(name, key)
+    for n := 2; ; n++ {
+        s := numberedName(key, n) + slash
+        _, entry := mg.index[modelKey(s)]
+        if _, dir := mg.parents[modelKey(s)]; !entry && !dir {
+            return s
+        }
+    }
+}
+
+// numberedName adds the suffix ~n to name before its extension.
+func numberedName(name string, n int) string {
+    ext := path.Ext(name)
+    if strings.HasPrefix(path.Base(name), ".") && path.Base(name) == ext {
+        ext = "" // a dot file such as .profile
+    }
+    return fmt.Sprintf("%s~%d%s", strings.TrimSuffix(name, ext), n, ext)
+}
+
+// remapName moves name from under the longest key of remap that it is
+// under to under the key's value.
+func remapName(name string, remap map[string]string) string {
+    var from, to string
+    found := false
+    for k, v := range remap {
+        key := modelKey(k)
+        if (key == "" || under(name, key)) && (!found || len(key) > len(from)) {
+            from, to, found = key, v, true
+        }
+    }
+    if !found {
+        return name
+    }
+    rest := strings.TrimPrefix(name, from)
+    if from == "" {
+        rest = "/" + name
+    }
+    return strings.TrimPrefix(modelKey(to)+rest, "/")
+}
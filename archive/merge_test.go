@@ -0,0 +1,172 @@
+package archive
+
+import (
+    "archive/tar"
+    "archive/zip"
+    "bytes"
+    "errors"
+    "io"
+    "io/ioutil"
+    "path/filepath"
+    "reflect"
+    "testing"
+    "time"
+)
+
+// modelContents returns the content of each regular file in an archive,
+// and the names of its directories with an empty content.
+func modelContents(t *testing.T, b []byte) map[string]string {
+    m, err := LoadModel(bytes.NewReader(b), int64(len(b)))
+    if err != nil {
+        t.Fatal(err)
+    }
+    contents := map[string]string{}
+    for _, e := range m.Entries() {
+        rc, err := e.Open()
+        if err != nil {
+            t.Fatal(err)
+        }
+        data, err := ioutil.ReadAll(rc)
+        rc.Close()
+        if err != nil {
+            t.Fatal(err)
+        }
+        contents[e.Name()] = string(data)
+    }
+    return contents
+}
+
+// mergeInputs writes a tar and a zip archive that share some names; the
+// zip entries are newer.
+func mergeInputs(t *testing.T) (string, string) {
+    dir := tempDir(t)
+    old := time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC)
+    var tbuf bytes.Buffer
+    tw := tar.NewWriter(&tbuf)
+    for _, f := range []struct{ name, body string }{
+        {"bin/", ""}, {"bin/tool", "tool from a"}, {"README", "readme from a"}, {"a-only", "a"},
+    } {
+        hdr := &tar.Header{Name: f.name, Mode: 0644, Size: int64(len(f.body)), ModTime: old, Typeflag: tar.TypeReg}
+        if f.name == "bin/" {
+            hdr.Typeflag = tar.TypeDir
+        }
+        tw.WriteHeader(hdr)
+        io.WriteString(tw, f.body)
+    }
+    tw.Close()
+    var zbuf bytes.Buffer
+    zw := zip.NewWriter(&zbuf)
+    for _, f := range []struct{ name, body string }{
+        {"bin/", ""}, {"bin/tool", "tool from b"}, {"README", "readme from b"},
+    } {
+        fw, _ := zw.CreateHeader(&zip.FileHeader{Name: f.name, Modified: old.Add(time.Hour)})
+        io.WriteString(fw, f.body)
+    }
+    zw.Close()
+    a, b := filepath.Join(dir, "a.tar"), filepath.Join(dir, "b.zip")
+    ioutil.WriteFile(a, tbuf.Bytes(), 0644)
+    ioutil.WriteFile(b, zbuf.Bytes(), 0644)
+    return a, b
+}
+
+func TestMergePolicies(t *testing.T) {
+    a, b := mergeInputs(t)
+    fromA := map[string]string{"bin/": "", "bin/tool": "tool from a", "README": "readme from a", "a-only": "a"}
+    fromB := map[string]string{"bin/": "", "bin/tool": "tool from b", "README": "readme from b", "a-only": "a"}
+    for _, tt := range []struct {
+        policy ConflictPolicy
+        inputs []string
+        want   map[string]string
+    }{
+        {FirstWins, []string{a, b}, fromA},
+        {LastWins, []string{a, b}, fromB},
+        {NewestWins, []string{a, b}, fromB},
+        {NewestWins, []string{b, a}, fromB},
+        {RenameConflicts, []string{a, b}, map[string]string{
+            "bin/": "", "bin/tool": "tool from a", "README": "readme from a", "a-only": "a",
+            "bin/tool~2": "tool from b", "README~2": "readme from b",
+        }},
+    } {
+        var inputs []MergeInput
+        for _, p := range tt.inputs {
+            inputs = append(inputs, MergeInput{Path: p})
+        }
+        for _, format := range []Format{FormatTar, FormatZip} {
+            var out bytes.Buffer
+            report, err := Merge(&out, inputs, format, &MergeOptions{Policy: tt.policy})
+            if err != nil {
+                t.Fatalf("policy %d: %v", tt.policy, err)
+            }
+            if got := modelContents(t, out.Bytes()); !reflect.DeepEqual(got, tt.want) {
+                t.Errorf("policy %d, %v: merged %v, want %v", tt.policy, format, got, tt.want)
+            }
+            if len(report.Conflicts) != 2 {
+                t.Errorf("policy %d: conflicts %+v", tt.policy, report.Conflicts)
+            }
+        }
+    }
+
+    var out bytes.Buffer
+    report, _ := Merge(&out, []MergeInput{{Path: a}, {Path: b}}, FormatTar, &MergeOptions{Policy: NewestWins})
+    want := MergeConflict{Name: "bin/tool", Existing: a, Incoming: b, Kept: b}
+    if report.Conflicts[0] != want {
+        t.Errorf("conflict = %+v, want %+v", report.Conflicts[0], want)
+    }
+
+    report, err := Merge(ioutil.Discard, []MergeInput{{Path: a}, {Path: b}}, FormatTar, &MergeOptions{Policy: ConflictError})
+    if !errors.Is(err, ErrConflict) || len(report.Conflicts) != 1 {
+        t.Errorf("ConflictError: err = %v, conflicts %+v", err, report.Conflicts)
+    }
+}
+
+func TestMergeRemap(t *testing.T) {
+    a, b := mergeInputs(t)
+    var out bytes.Buffer
+    report, err := Merge(&out, []MergeInput{
+        {Path: a, Remap: map[string]string{"bin": "tools/a", "README": "docs/README.a"}},
+        {Path: b, Remap: map[string]string{"": "team-b/", "bin/": ""}},
+    }, FormatZip, &MergeOptions{Policy: ConflictError})
+    if err != nil {
+        t.Fatal(err)
+    }
+    want := map[string]string{
+        "tools/a/": "", "tools/a/tool": "tool from a", "docs/README.a": "readme from a", "a-only": "a",
+        "tool": "tool from b", "team-b/README": "readme from b",
+    }
+    if got := modelContents(t, out.Bytes()); !reflect.DeepEqual(got, want) {
+        t.Errorf("merged %v, want %v", got, want)
+    }
+    if len(report.Conflicts) != 0 {
+        t.Errorf("conflicts %+v", report.Conflicts)
+    }
+}
+
+func TestMergeDirectoryConflict(t *testing.T) {
+    dir := tempDir(t)
+    a := filepath.Join(dir, "a.zip")
+    b := filepath.Join(dir, "b.zip")
+    ioutil.WriteFile(a, zipBytes(t, map[string][]byte{"lib": []byte("a file")}), 0644)
+    ioutil.WriteFile(b, zipBytes(t, map[string][]byte{"lib/x.so": []byte("inside")}), 0644)
+    inputs := []MergeInput{{Path: a}, {Path: b}}
+
+    if _, err := Merge(ioutil.Discard, inputs, FormatZip, &MergeOptions{Policy: LastWins}); !errors.Is(err, ErrConflict) {
+        t.Errorf("file and directory merged: %v", err)
+    }
+    var out bytes.Buffer
+    if _, err := Merge(&out, inputs, FormatZip, &MergeOptions{Policy: RenameConflicts}); err != nil {
+        t.Fatal(err)
+    }
+    got := modelContents(t, out.Bytes())
+    if got["lib"] != "a file" || got["lib~2/x.so"] != "inside" {
+        t.Errorf("merged %v", got)
+    }
+
+    out.Reset()
+    if _, err := Merge(&out, []MergeInput{{Path: b}, {Path: a}}, FormatZip, &MergeOptions{Policy: RenameConflicts}); err != nil {
+        t.Fatal(err)
+    }
+    got = modelContents(t, out.Bytes())
+    if got["lib/x.so"] != "inside" || got["lib~2"] != "a file" {
+        t.Errorf("merged %v", got)
+    }
+}
@@ -0,0 +1,96 @@
+package archive
+
+import (
+    "archive/zip"
+    "bytes"
+    "errors"
+    "fmt"
+    "os"
+)
+
+// ErrNotStored is returned by MappedZip.Bytes for entries that are
+// compressed or encrypted, whose content is not in the archive as is.
+var ErrNotStored = errors.New("archive: entry is not stored")
+
+// MappedZip is a zip archive mapped into memory read-only, whose stored
+// entries can be used in place, as slices of the mapping, rather than
+// read into buffers of their own. Archives written by WriteZip with
+// CreateOptions.Align set to the page size have every stored entry start
+// on a page, so the slices are as aligned as the system allows.
+//
+// Where memory mapping is not available the archive is read into memory
+// once instead.
+type MappedZip struct {
+    *zip.Reader
+    data  []byte
+    unmap func([]byte) error
+    files map[string]*zip.File
+}
+
+// OpenMappedZip maps the zip archive in the named file.
+func OpenMappedZip(name string) (*MappedZip, error) {
+    f, err := os.Open(name)
+    if err != nil {
+        return nil, err
+    }
+    defer f.Close()
+    fi, err := f.Stat()
+    if err != nil {
+        return nil, err
+    }
+    if fi.Size() == 0 || int64(int(fi.Size())) != fi.Size() {
+        return nil, fmt.Errorf("%w: cannot map %s of %d bytes", ErrFormat, name, fi.Size())
+    }
+    z := &MappedZip{}
+    if z.data, z.unmap, err = mapFile(f, int(fi.Size())); err != nil {
+        return nil, err
+    }
+    if z.Reader, err = OpenDictZip(bytes.NewReader(z.data), fi.Size()); err != nil {
+        z.Close()
+        return nil, err
+    }
+    z.files = make(map[string]*zip.File, len(z.File))
+    for _, f := range z.File {
+        if _, ok := z.files[f.Name]; !ok {
+            z.files[f.Name] = f
+        }
+    }
+    return z, nil
+}
+
+// Bytes returns the content of the stored entry name. The slice shares
+// the mapping: it must not be written to, and not be used after Close.
+// It is not checked against the entry's CRC; opening the entry does that.
+func (z *MappedZip) Bytes(name string) ([]byte, error) {
+    f, ok := z.files[name]
+    if !ok {
+        return nil, &EntryError{Name: name, Err: os.ErrNotExist}
+    }
+    return z.FileBytes(f)
+}
+
+// FileBytes is like Bytes for an entry of z.File.
+func (z *MappedZip) FileBytes(f *zip.File) ([]byte, error) {
+    if f.Method != zip.Store || f.Flags&0x1 != 0 {
+        return nil, &EntryError{Name: f.Name, Err: ErrNotStored}
+    }
+    off, err := f.DataOffset()
+    if err != nil {
+        return nil, &EntryError{Name: f.Name, Err: err}
+    }
+    end := off + int64(f.CompressedSize64)
+    if f.CompressedSize64 != f.UncompressedSize64 || end > int64(len(z.data)) {
+        return nil, &EntryError{Name: f.Name, Err: ErrFormat}
+    }
+    return z.data[off:end:end], nil
+}
+
+// Close unmaps the archive.
+func (z *MappedZip) Close() error {
+    if z.data == nil {
+        return nil
+    }
+    data := z.data
+    z.data, z.files = nil, nil
+    return z.unmap(data)
+}
@@ -0,0 +1,18 @@
+//go:build !aix && !darwin && !dragonfly && !freebsd && !linux && !netbsd && !openbsd && !solaris
+// +build !aix,!darwin,!dragonfly,!freebsd,!linux,!netbsd,!openbsd,!solaris
+
+package archive
+
+import (
+    "io"
+    "os"
+)
+
+// mapFile reads the first size bytes of f, lacking mmap.
+func mapFile(f *os.File, size int) ([]byte, func([]byte) error, error) {
+    data := make([]byte, size)
+    if _, err := io.ReadFull(f, data); err != nil {
+        return nil, nil, err
+    }
+    return data, func([]byte) error { return nil }, nil
+}
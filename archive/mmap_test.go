@@ -0,0 +1,94 @@
+package archive
+
+import (
+    "archive/zip"
+    "bytes"
+    "context"
+    "errors"
+    "io/ioutil"
+    "math/rand"
+    "os"
+    "path/filepath"
+    "strings"
+    "testing"
+)
+
+func TestAlignedZip(t *testing.T) {
+    rnd := rand.New(rand.NewSource(1))
+    noise := make([]byte, 10000)
+    rnd.Read(noise)
+    files := map[string]string{
+        "a.png":         string(noise[:3333]),
+        "model/w.bin":   string(noise),
+        "model/odd.bin": string(noise[:1]),
+        "readme.txt":    strings.Repeat("compressible ", 100),
+        "empty.png":     "",
+        // Deflated files followed by a stored one and by a directory.
+        "b.txt":   strings.Repeat("b", 5000),
+        "c.png":   string(noise[:77]),
+        "d.txt":   strings.Repeat("d", 5000),
+        "e/f.png": string(noise[:5]),
+    }
+    src := tempDir(t)
+    writeTree(t, src, files)
+    if err := os.Symlink("readme.txt", filepath.Join(src, "link")); err != nil {
+        t.Fatal(err)
+    }
+    name := filepath.Join(tempDir(t), "pack.zip")
+    policy := &CompressionPolicy{StoreExtensions: []string{".png", ".bin"}}
+    if err := Create(context.Background(), name, src, &CreateOptions{Compression: policy, Align: 4096}); err != nil {
+        t.Fatal(err)
+    }
+
+    z, err := OpenMappedZip(name)
+    if err != nil {
+        t.Fatal(err)
+    }
+    defer z.Close()
+    stored := 0
+    for _, f := range z.File {
+        // The standard reader accepts the padding and checks the CRCs.
+        rc, err := f.Open()
+        if err != nil {
+            t.Fatal(err)
+        }
+        content, err := ioutil.ReadAll(rc)
+        rc.Close()
+        if err != nil {
+            t.Fatalf("%s: %v", f.Name, err)
+        }
+        // The padding is in the local header only.
+        if len(stripExtra(f.Extra, zipAlignExtraID)) != len(f.Extra) {
+            t.Errorf("%s has padding in the central directory", f.Name)
+        }
+        if f.Method != zip.Store || f.FileInfo().IsDir() {
+            if _, err := z.FileBytes(f); f.Method != zip.Store && !errors.Is(err, ErrNotStored) {
+                t.Errorf("%s: FileBytes of a deflated entry: %v", f.Name, err)
+            }
+            continue
+        }
+        stored++
+        if f.Flags&zipFlagDescriptor != 0 {
+            t.Errorf("%s has a data descriptor", f.Name)
+        }
+        if off, _ := f.DataOffset(); off%4096 != 0 {
+            t.Errorf("%s starts at %d", f.Name, off)
+        }
+        b, err := z.Bytes(f.Name)
+        if err != nil || !bytes.Equal(b, content) {
+            t.Errorf("Bytes(%s) = %d bytes, %v", f.Name, len(b), err)
+        }
+    }
+    if stored != 7 {
+        t.Errorf("%d stored entries, want 7", stored)
+    }
+    if _, err := z.Bytes("missing"); !errors.Is(err, os.ErrNotExist) {
+        t.Errorf("Bytes of a missing entry: %v", err)
+    }
+
+    dst := tempDir(t)
+    if err := Extract(context.Background(), name, dst, nil); err != nil {
+        t.Fatal(err)
+    }
+    checkTree(t, dst, files)
+}
@@ -0,0 +1,18 @@
+//go:build aix || darwin || dragonfly || freebsd || linux || netbsd || openbsd || solaris
+// +build aix darwin dragonfly freebsd linux netbsd openbsd solaris
+
+package archive
+
+import (
+    "os"
+    "syscall"
+)
+
+// mapFile maps the first size bytes of f read-only.
+func mapFile(f *os.File, size int) ([]byte, func([]byte) error, error) {
+    data, err := syscall.Mmap(int(f.Fd()), 0, size, syscall.PROT_READ, syscall.MAP_SHARED)
+    if err != nil {
+        return nil, nil, &os.PathError{Op: "mmap", Path: f.Name(), Err: err}
+    }
+    return data, syscall.Munmap, nil
+}
@@ -0,0 +1,521 @@
+package archive
+
+import (
+    "archive/tar"
+    "archive/zip"
+    "bytes"
+    "fmt"
+    "io"
+    "io/ioutil"
+    "os"
+    "strings"
+    "time"
+)
+
+// Model is an editable, in-memory view of a tar or zip archive. Entries
+// keep their order, and content is read from the source archive only when
+// it is needed, so the source must remain readable until the model has
+// been saved. Entries are addressed by name, with or without the trailing
+// slash of directories.
+type Model struct {
+    // Comment is the archive comment: the zip end record comment, or the
+    // comment record of a tar global header.
+    Comment string
+    entries []*ModelEntry
+}
+
+// ModelEntry is one member of a Model.
+type ModelEntry struct {
+    hdr     *tar.Header
+    comment string
+    open    func() (io.ReadCloser, error)
+    zf      *zip.File // the source entry, while its content is unchanged
+}
+
+// Header returns a copy of the entry's header.
+func (e *ModelEntry) Header() *tar.Header {
+    h := *e.hdr
+    return &h
+}
+
+// Name returns the entry name.
+func (e *ModelEntry) Name() string { return e.hdr.Name }
+
+// Comment returns the entry comment.
+func (e *ModelEntry) Comment() string { return e.comment }
+
+// Open returns a reader for the entry content.
+func (e *ModelEntry) Open() (io.ReadCloser, error) {
+    if e.open == nil {
+        return ioutil.NopCloser(strings.NewReader("")), nil
+    }
+    return e.open()
+}
+
+// NewModel returns an empty model.
+func NewModel() *Model { return &Model{} }
+
+// LoadModel loads the tar or zip archive in r. Zip entries compressed
+// against a preset dictionary are supported.
+func LoadModel(r io.ReaderAt, size int64) (*Model, error) {
+    switch DetectFormat(r) {
+    case FormatZip:
+        return loadZipModel(r, size)
+    case FormatTar:
+        return loadTarModel(r, size)
+    }
+    return nil, ErrFormat
+}
+
+// loadModelFile loads the named archive. Tar and zip archives are read in
+// place from the returned file, which must stay open until the model has
+// been saved; other formats are read into memory.
+func loadModelFile(name string) (*Model, *os.File, error) {
+    f, err := os.Open(name)
+    if err != nil {
+        return nil, nil, err
+    }
+    fi, err := f.Stat()
+    if err != nil {
+        return nil, f, err
+    }
+    m, err := LoadModel(f, fi.Size())
+    if err == ErrFormat {
+        m, err = loadSequentialModel(f, fi.Size())
+    }
+    return m, f, err
+}
+
+func loadZipModel(r io.ReaderAt, size int64) (*Model, error) {
+    zr, err := OpenDictZip(r, size)
+    if err != nil {
+        return nil, err
+    }
+    m := &Model{Comment: zr.Comment}
+    for _, f := range zr.File {
+        e := &ModelEntry{hdr: zipHeader(&f.FileHeader), comment: f.Comment, open: f.Open, zf: f}
+        if e.hdr.Typeflag == tar.TypeSymlink {
+            link, err := readAllLimited(f.Open, 4096)
+            if err != nil {
+                return nil, &EntryError{Name: f.Name, Err: err}
+            }
+            e.hdr.Linkname = string(link)
+        }
+        m.entries = append(m.entries, e)
+    }
+    return m, nil
+}
+
+func loadTarModel(r io.ReaderAt, size int64) (*Model, error) {
+    sr := io.NewSectionReader(r, 0, size)
+    tr := tar.NewReader(sr)
+    m := &Model{}
+    for i := 0; ; i++ {
+        hdr, err := tr.Next()
+        if err == io.EOF {
+            return m, nil
+        }
+        if err != nil {
+            return nil, err
+        }
+        if hdr.Typeflag == tar.TypeXGlobalHeader {
+            m.Comment = hdr.PAXRecords["comment"]
+            i--
+            continue
+        }
+        e := &ModelEntry{hdr: hdr, comment: hdr.PAXRecords["comment"]}
+        sparse := tarSparse(hdr)
+        if hdr.Typeflag == tar.TypeRegA || hdr.Typeflag == tar.TypeGNUSparse {
+            hdr.Typeflag = tar.TypeReg
+        }
+        if hdr.Typeflag == tar.TypeReg {
+            off, _ := sr.Seek(0, io.SeekCurrent)
+            if sparse {
+                e.open = tarEntryOpener(r, size, i)
+            } else {
+                data := io.NewSectionReader(r, off, hdr.Size)
+                e.open = func() (io.ReadCloser, error) {
+                    return ioutil.NopCloser(io.NewSectionReader(data, 0, data.Size())), nil
+                }
+            }
+        }
+        m.entries = append(m.entries, e)
+    }
+}
+
+// tarSparse reports whether the content of hdr is stored in sparse
+// fragments rather than contiguously.
+func tarSparse(hdr *tar.Header) bool {
+    if hdr.Typeflag == tar.TypeGNUSparse {
+        return true
+    }
+    for k := range hdr.PAXRecords {
+        if strings.HasPrefix(k, "GNU.sparse.") {
+            return true
+        }
+    }
+    return false
+}
+
+// tarEntryOpener returns a function reading the content of the i-th entry
+// of the tar archive in r by reading the archive up to it.
+func tarEntryOpener(r io.ReaderAt, size int64, i int) func() (io.ReadCloser, error) {
+    return func() (io.ReadCloser, error) {
+        tr := tar.NewReader(io.NewSectionReader(r, 0, size))
+        for n := 0; ; {
+            hdr, err := tr.Next()
+            if err != nil {
+                return nil, unexpected(err)
+            }
+            if hdr.Typeflag == tar.TypeXGlobalHeader {
+                continue
+            }
+            if n == i {
+                return ioutil.NopCloser(tr), nil
+            }
+            n++
+        }
+    }
+}
+
+func readAllLimited(open func() (io.ReadCloser, error), n int64) ([]byte, error) {
+    rc, err := open()
+    if err != nil {
+        return nil, err
+    }
+    defer rc.Close()
+    return ioutil.ReadAll(io.LimitReader(rc, n))
+}
+
+// modelKey is the name entries are matched by.
+func modelKey(name string) string { return strings.TrimSuffix(name, "/") }
+
+func (m *Model) find(name string) int {
+    key := modelKey(name)
+    for i, e := range m.entries {
+        if modelKey(e.hdr.Name) == key {
+            return i
+        }
+    }
+    return -1
+}
+
+func (m *Model) lookup(name string) (*ModelEntry, error) {
+    i := m.find(name)
+    if i < 0 {
+        return nil, &EntryError{Name: name, Err: os.ErrNotExist}
+    }
+    return m.entries[i], nil
+}
+
+// Entries returns the entries in archive order.
+func (m *Model) Entries() []*ModelEntry {
+    return append([]*ModelEntry(nil), m.entries...)
+}
+
+// Entry returns the named entry, or nil.
+func (m *Model) Entry(name string) *ModelEntry {
+    if i := m.find(name); i >= 0 {
+        return m.entries[i]
+    }
+    return nil
+}
+
+// Add adds an entry with the given header and content, replacing an entry
+// of the same name in place. Size is set from the content of regular
+// files.
+func (m *Model) Add(hdr *tar.Header, content []byte) error {
+    if _, err := cleanName(hdr.Name); err != nil {
+        return &EntryError{Name: hdr.Name, Err: err}
+    }
+    h := *hdr
+    if h.Typeflag == tar.TypeRegA {
+        h.Typeflag = tar.TypeReg
+    }
+    e := &ModelEntry{hdr: &h, comment: h.PAXRecords["comment"]}
+    if h.Typeflag == tar.TypeReg {
+        data := append([]byte(nil), content...)
+        h.Size = int64(len(data))
+        e.open = func() (io.ReadCloser, error) {
+            return ioutil.NopCloser(bytes.NewReader(data)), nil
+        }
+    } else {
+        h.Size = 0
+    }
+    if i := m.find(h.Name); i >= 0 {
+        m.entries[i] = e
+    } else {
+        m.entries = append(m.entries, e)
+    }
+    return nil
+}
+
+// under reports whether name is dir itself or inside it.
+func under(name, dir string) bool {
+    name, dir = modelKey(name), modelKey(dir)
+    return name == dir || strings.HasPrefix(name, dir+"/")
+}
+
+// Remove removes the named entry. Removing a directory also removes the
+// entries inside it.
+func (m *Model) Remove(name string) error {
+    if _, err := m.lookup(name); err != nil {
+        return err
+    }
+    kept := m.entries[:0]
+    for _, e := range m.entries {
+        if !under(e.hdr.Name, name) {
+            kept = append(kept, e)
+        }
+    }
+    for i := len(kept); i < len(m.entries); i++ {
+        m.entries[i] = nil
+    }
+    m.entries = kept
+    return nil
+}
+
+// Rename renames an entry. Renaming a directory moves the entries inside
+// it too. It fails if an entry named newName already exists.
+func (m *Model) Rename(oldName, newName string) error {
+    if _, err := m.lookup(oldName); err != nil {
+        return err
+    }
+    if _, err := cleanName(newName); err != nil {
+        return &EntryError{Name: newName, Err: err}
+    }
+    if m.find(newName) >= 0 {
+        return &EntryError{Name: newName, Err: os.ErrExist}
+    }
+    from, to := modelKey(oldName), modelKey(newName)
+    for _, e := range m.entries {
+        if !under(e.hdr.Name, oldName) {
+            continue
+        }
+        h := *e.hdr
+        dir := strings.HasSuffix(h.Name, "/")
+        h.Name = to + strings.TrimPrefix(modelKey(h.Name), from)
+        if dir {
+            h.Name += "/"
+        }
+        e.hdr = &h
+    }
+    return nil
+}
+
+// SetMode sets the permission and setuid, setgid and sticky bits of an
+// entry. Other mode bits are ignored; the entry type does not change.
+func (m *Model) SetMode(name string, mode os.FileMode) error {
+    e, err := m.lookup(name)
+    if err != nil {
+        return err
+    }
+    h := *e.hdr
+    h.Mode = int64(mode.Perm())
+    if mode&os.ModeSetuid != 0 {
+        h.Mode |= 04000
+    }
+    if mode&os.ModeSetgid != 0 {
+        h.Mode |= 02000
+    }
+    if mode&os.ModeSticky != 0 {
+        h.Mode |= 01000
+    }
+    e.hdr = &h
+    return nil
+}
+
+// SetMTime sets the modification time of an entry.
+func (m *Model) SetMTime(name string, t time.Time) error {
+    e, err := m.lookup(name)
+    if err != nil {
+        return err
+    }
+    h := *e.hdr
+    h.ModTime = t
+    e.hdr = &h
+    return nil
+}
+
+// SetComment sets the comment of an entry. The archive comment is the
+// Comment field of the Model.
+func (m *Model) SetComment(name, comment string) error {
+    e, err := m.lookup(name)
+    if err != nil {
+        return err
+    }
+    e.comment = comment
+    return nil
+}
+
+// Save writes the model to w as a tar or zip archive. Zip entries whose
+// content has not been replaced are copied without recompressing them.
+func (m *Model) Save(w io.Writer, format Format) error {
+    switch format {
+    case FormatZip:
+        return m.saveZip(w)
+    case FormatTar:
+        return m.saveTar(w)
+    }
+    return fmt.Errorf("%w: cannot save as %v", ErrFormat, format)
+}
+
+// usesDictionary reports whether entries loaded from a zip archive were
+// compressed against a preset dictionary. They are saved decompressed, so
+// the dictionary member is dropped.
+func (m *Model) usesDictionary() bool {
+    for _, e := range m.entries {
+        if e.zf != nil && e.zf.Method == DictDeflate {
+            return true
+        }
+    }
+    return false
+}
+
+func (m *Model) saveZip(w io.Writer) error {
+    zw := newZipWriter(w)
+    if err := zw.SetComment(m.Comment); err != nil {
+        return err
+    }
+    dict := m.usesDictionary()
+    for _, e := range m.entries {
+        if dict && e.hdr.Name == DictionaryName {
+            continue
+        }
+        if err := e.saveZip(zw); err != nil {
+            return err
+        }
+    }
+    return zw.Close()
+}
+
+func (e *ModelEntry) saveZip(zw *zip.Writer) error {
+    hdr := e.hdr
+    fh := &zip.FileHeader{Name: hdr.Name, Modified: hdr.ModTime, Comment: e.comment}
+    mode := tarMode(hdr)
+    switch hdr.Typeflag {
+    case tar.TypeDir:
+        mode |= os.ModeDir
+        if !strings.HasSuffix(fh.Name, "/") {
+            fh.Name += "/"
+        }
+    case tar.TypeSymlink:
+        mode |= os.ModeSymlink
+    case tar.TypeReg:
+    default:
+        // Zip has no hard links, devices or FIFOs.
+        return &EntryError{Name: hdr.Name, Err: fmt.Errorf("%w: cannot store %c entries in zip", ErrFormat, hdr.Typeflag)}
+    }
+    fh.SetMode(mode)
+
+    if zf := e.zf; zf != nil && zf.Method != DictDeflate {
+        raw := zf.FileHeader
+        raw.Name, raw.Comment = fh.Name, fh.Comment
+        if raw.Name != zf.Name || raw.Comment != zf.Comment {
+            // Renamed entries are written in UTF-8 where possible.
+            raw.NonUTF8, raw.Flags = false, raw.Flags&^0x800
+            raw.Extra = stripExtra(raw.Extra, unicodePathExtraID, unicodeCommentExtraID)
+        }
+        setUTF8Flag(&raw)
+        raw.CreatorVersion, raw.ExternalAttrs = fh.CreatorVersion, fh.ExternalAttrs
+        raw.Flags &^= zipFlagDescriptor
+        // The writer adds its own zip64 field.
+        raw.Extra = stripExtra(raw.Extra, zip64ExtraID)
+        setRawModified(&raw, hdr.ModTime)
+        r, err := zf.OpenRaw()
+        if err != nil {
+            return &EntryError{Name: hdr.Name, Err: err}
+        }
+        fw, err := zw.CreateRaw(&raw)
+        if err != nil {
+            return err
+        }
+        _, err = copyBuffer(fw, r)
+        return err
+    }
+
+    if hdr.Typeflag == tar.TypeReg {
+        fh.Method = zip.Deflate
+    }
+    setZipModified(fh, hdr.ModTime)
+    fw, err := zw.CreateHeader(fh)
+    if err != nil {
+        return err
+    }
+    switch hdr.Typeflag {
+    case tar.TypeSymlink:
+        _, err = io.WriteString(fw, hdr.Linkname)
+    case tar.TypeReg:
+        err = e.copyTo(fw)
+    }
+    return err
+}
+
+func (e *ModelEntry) copyTo(w io.Writer) error {
+    rc, err := e.Open()
+    if err != nil {
+        return &EntryError{Name: e.hdr.Name, Err: err}
+    }
+    defer rc.Close()
+    if _, err := copyBuffer(w, rc); err != nil {
+        return &EntryError{Name: e.hdr.Name, Err: err}
+    }
+    return nil
+}
+
+func (m *Model) saveTar(w io.Writer) error {
+    tw := tar.NewWriter(w)
+    if m.Comment != "" {
+        err := tw.WriteHeader(&tar.Header{
+            Typeflag:   tar.TypeXGlobalHeader,
+            PAXRecords: map[string]string{"comment": m.Comment},
+        })
+        if err != nil {
+            return err
+        }
+    }
+    dict := m.usesDictionary()
+    for _, e := range m.entries {
+        if dict && e.hdr.Name == DictionaryName {
+            continue
+        }
+        if err := e.saveTar(tw); err != nil {
+            return err
+        }
+    }
+    return tw.Close()
+}
+
+func (e *ModelEntry) saveTar(tw *tar.Writer) error {
+    hdr := *e.hdr
+    hdr.PAXRecords = nil
+    for k, v := range e.hdr.PAXRecords {
+        if !strings.HasPrefix(k, "GNU.sparse.") && k != "comment" {
+            if hdr.PAXRecords == nil {
+                hdr.PAXRecords = map[string]string{}
+            }
+            hdr.PAXRecords[k] = v
+        }
+    }
+    if e.comment != "" {
+        if hdr.PAXRecords == nil {
+            hdr.PAXRecords = map[string]string{}
+        }
+        hdr.PAXRecords["comment"] = e.comment
+    }
+    if hdr.Typeflag == tar.TypeDir && !strings.HasSuffix(hdr.Name, "/") {
+        hdr.Name += "/"
+    }
+    if hdr.Typeflag != tar.TypeReg {
+        hdr.Size = 0
+    }
+    hdr.Format = tar.FormatUnknown
+    keepTarNanos(&hdr)
+    if err := tw.WriteHeader(&hdr); err != nil {
+        return &EntryError{Name: hdr.Name, Err: err}
+    }
+    if hdr.Typeflag == tar.TypeReg {
+        return e.copyTo(tw)
+    }
+    return nil
+}
@@ -0,0 +1,177 @@
+package archive
+
+import (
+    "archive/tar"
+    "archive/zip"
+    "bytes"
+    "errors"
+    "io/ioutil"
+    "os"
+    "strings"
+    "testing"
+    "time"
+)
+
+var modelTime = time.Date(2020, 5, 17, 10, 30, 0, 0, time.UTC)
+
+func modelZip(t *testing.T) []byte {
+    var buf bytes.Buffer
+    zw := zip.NewWriter(&buf)
+    add := func(name string, mode os.FileMode, body string) {
+        fh := &zip.FileHeader{Name: name, Method: zip.Deflate, Modified: modelTime}
+        fh.SetMode(mode)
+        fw, err := zw.CreateHeader(fh)
+        if err != nil {
+            t.Fatal(err)
+        }
+        fw.Write([]byte(body))
+    }
+    add("readme.txt", 0644, "This archive contains some text files.")
+    add("docs/", os.ModeDir|0755, "")
+    add("docs/gopher.txt", 0644, strings.Repeat("Gopher names:\nGeorge\nGeoffrey\nGonzo\n", 50))
+    add("docs/link", os.ModeSymlink|0777, "gopher.txt")
+    zw.SetComment("original")
+    if err := zw.Close(); err != nil {
+        t.Fatal(err)
+    }
+    return buf.Bytes()
+}
+
+// edit applies the same changes to a loaded model.
+func edit(t *testing.T, m *Model) {
+    steps := []error{
+        m.Rename("docs", "notes"),
+        m.Remove("readme.txt"),
+        m.SetMode("notes/gopher.txt", 0600),
+        m.SetMTime("notes/gopher.txt", modelTime.Add(time.Hour)),
+        m.SetComment("notes/gopher.txt", "the gophers"),
+        m.Add(&tar.Header{Name: "todo.txt", Mode: 0644, ModTime: modelTime, Typeflag: tar.TypeReg}, []byte("Write more examples.")),
+    }
+    for _, err := range steps {
+        if err != nil {
+            t.Fatal(err)
+        }
+    }
+    m.Comment = "edited"
+}
+
+func checkModel(t *testing.T, m *Model) {
+    var names []string
+    for _, e := range m.Entries() {
+        names = append(names, e.Name())
+    }
+    if got, want := strings.Join(names, ","), "notes/,notes/gopher.txt,notes/link,todo.txt"; got != want {
+        t.Fatalf("entries %s, want %s", got, want)
+    }
+    e := m.Entry("notes/gopher.txt")
+    hdr := e.Header()
+    if hdr.Mode&0777 != 0600 || !hdr.ModTime.Equal(modelTime.Add(time.Hour)) || e.Comment() != "the gophers" {
+        t.Errorf("notes/gopher.txt: mode %o, time %v, comment %q", hdr.Mode, hdr.ModTime, e.Comment())
+    }
+    rc, err := e.Open()
+    if err != nil {
+        t.Fatal(err)
+    }
+    b, _ := ioutil.ReadAll(rc)
+    rc.Close()
+    if !strings.HasPrefix(string(b), "Gopher names:") || len(b) != 50*36 {
+        t.Errorf("notes/gopher.txt: %d bytes", len(b))
+    }
+    if l := m.Entry("notes/link").Header(); l.Typeflag != tar.TypeSymlink || l.Linkname != "gopher.txt" {
+        t.Errorf("notes/link: %c -> %q", l.Typeflag, l.Linkname)
+    }
+    if m.Comment != "edited" {
+        t.Errorf("comment %q", m.Comment)
+    }
+}
+
+func TestModelZip(t *testing.T) {
+    data := modelZip(t)
+    m, err := LoadModel(bytes.NewReader(data), int64(len(data)))
+    if err != nil {
+        t.Fatal(err)
+    }
+    if m.Comment != "original" {
+        t.Errorf("comment %q", m.Comment)
+    }
+    edit(t, m)
+    var buf bytes.Buffer
+    if err := m.Save(&buf, FormatZip); err != nil {
+        t.Fatal(err)
+    }
+    saved, err := LoadModel(bytes.NewReader(buf.Bytes()), int64(buf.Len()))
+    if err != nil {
+        t.Fatal(err)
+    }
+    checkModel(t, saved)
+
+    // The unchanged content was copied without recompressing it.
+    orig, _ := zip.NewReader(bytes.NewReader(data), int64(len(data)))
+    out, _ := zip.NewReader(bytes.NewReader(buf.Bytes()), int64(buf.Len()))
+    raw := func(zr *zip.Reader, name string) []byte {
+        for _, f := range zr.File {
+            if f.Name == name {
+                r, err := f.OpenRaw()
+                if err != nil {
+                    t.Fatal(err)
+                }
+                b, _ := ioutil.ReadAll(r)
+                return b
+            }
+        }
+        t.Fatalf("%s not found", name)
+        return nil
+    }
+    if !bytes.Equal(raw(orig, "docs/gopher.txt"), raw(out, "notes/gopher.txt")) {
+        t.Errorf("notes/gopher.txt was recompressed")
+    }
+}
+
+func TestModelTar(t *testing.T) {
+    // Convert the zip to tar through a model, then edit the tar.
+    data := modelZip(t)
+    m, err := LoadModel(bytes.NewReader(data), int64(len(data)))
+    if err != nil {
+        t.Fatal(err)
+    }
+    var tarBuf bytes.Buffer
+    if err := m.Save(&tarBuf, FormatTar); err != nil {
+        t.Fatal(err)
+    }
+    m, err = LoadModel(bytes.NewReader(tarBuf.Bytes()), int64(tarBuf.Len()))
+    if err != nil {
+        t.Fatal(err)
+    }
+    if m.Comment != "original" {
+        t.Errorf("comment %q", m.Comment)
+    }
+    edit(t, m)
+    var buf bytes.Buffer
+    if err := m.Save(&buf, FormatTar); err != nil {
+        t.Fatal(err)
+    }
+    saved, err := LoadModel(bytes.NewReader(buf.Bytes()), int64(buf.Len()))
+    if err != nil {
+        t.Fatal(err)
+    }
+    checkModel(t, saved)
+}
+
+func TestModelErrors(t *testing.T) {
+    m := NewModel()
+    m.Add(&tar.Header{Name: "a", Typeflag: tar.TypeReg}, nil)
+    m.Add(&tar.Header{Name: "b", Typeflag: tar.TypeReg}, nil)
+    if err := m.Rename("a", "b"); !errors.Is(err, os.ErrExist) {
+        t.Errorf("Rename onto an existing entry: %v", err)
+    }
+    if err := m.Remove("c"); !errors.Is(err, os.ErrNotExist) {
+        t.Errorf("Remove of a missing entry: %v", err)
+    }
+    if err := m.Add(&tar.Header{Name: "../evil", Typeflag: tar.TypeReg}, nil); !errors.Is(err, ErrInsecurePath) {
+        t.Errorf("Add of an insecure name: %v", err)
+    }
+    m.Add(&tar.Header{Name: "fifo", Typeflag: tar.TypeFifo}, nil)
+    if err := m.Save(ioutil.Discard, FormatZip); !errors.Is(err, ErrFormat) {
+        t.Errorf("saving a FIFO to zip: %v", err)
+    }
+}
@@ -0,0 +1,133 @@
+package archive
+
+import (
+    "sort"
+    "strings"
+    "sync"
+)
+
+// Hangul syllables are composed and decomposed algorithmically.
+const (
+    hangulSBase  = 0xac00
+    hangulLBase  = 0x1100
+    hangulVBase  = 0x1161
+    hangulTBase  = 0x11a7
+    hangulLCount = 19
+    hangulVCount = 21
+    hangulTCount = 28
+    hangulNCount = hangulVCount * hangulTCount
+    hangulSCount = hangulLCount * hangulNCount
+)
+
+var nfcTables struct {
+    once       sync.Once
+    decompose  map[rune][]rune
+    composites map[[2]rune]rune
+}
+
+func loadNFC() {
+    nfcTables.once.Do(func() {
+        nfcTables.decompose = map[rune][]rune{}
+        for _, f := range strings.Fields(nfcDecompositions) {
+            r := []rune(f)
+            nfcTables.decompose[r[0]] = r[1:]
+        }
+        nfcTables.composites = map[[2]rune]rune{}
+        r := []rune(nfcCompositions)
+        for i := 0; i+2 < len(r); i += 3 {
+            nfcTables.composites[[2]rune{r[i+1], r[i+2]}] = r[i]
+        }
+    })
+}
+
+// nfc returns s in Unicode Normalization Form C: canonically decomposed,
+// with combining marks in canonical order, then recomposed.
+func nfc(s string) string {
+    if isASCII(s) {
+        return s
+    }
+    loadNFC()
+    var b []rune
+    for _, r := range s {
+        b = appendDecomposed(b, r)
+    }
+    for i := 1; i < len(b); i++ {
+        c := combiningClass(b[i])
+        if c == 0 {
+            continue
+        }
+        for j := i; j > 0 && combiningClass(b[j-1]) > c; j-- {
+            b[j-1], b[j] = b[j], b[j-1]
+        }
+    }
+    return string(compose(b))
+}
+
+func appendDecomposed(b []rune, r rune) []rune {
+    if r >= hangulSBase && r < hangulSBase+hangulSCount {
+        i := r - hangulSBase
+        b = append(b, hangulLBase+i/hangulNCount, hangulVBase+i%hangulNCount/hangulTCount)
+        if t := i % hangulTCount; t != 0 {
+            b = append(b, hangulTBase+t)
+        }
+        return b
+    }
+    if d, ok := nfcTables.decompose[r]; ok {
+        return append(b, d...)
+    }
+    return append(b, r)
+}
+
+// compose combines each character of b with the last starter before it
+// where possible, in place.
+func compose(b []rune) []rune {
+    if len(b) == 0 {
+        return b
+    }
+    starter := 0
+    last := combiningClass(b[0])
+    if last != 0 {
+        starter = -1
+    }
+    out := b[:1]
+    for _, r := range b[1:] {
+        c := combiningClass(r)
+        // r is blocked from the starter by any character in between of
+        // the same or a higher class.
+        if starter >= 0 && (starter == len(out)-1 || last != 0 && last < c) {
+            if p, ok := composePair(out[starter], r); ok {
+                out[starter] = p
+                continue
+            }
+        }
+        if c == 0 {
+            starter = len(out)
+        }
+        last = c
+        out = append(out, r)
+    }
+    return out
+}
+
+func composePair(a, b rune) (rune, bool) {
+    switch {
+    case a >= hangulLBase && a < hangulLBase+hangulLCount && b >= hangulVBase && b < hangulVBase+hangulVCount:
+        return hangulSBase + ((a-hangulLBase)*hangulVCount+b-hangulVBase)*hangulTCount, true
+    case a >= hangulSBase && a < hangulSBase+hangulSCount && (a-hangulSBase)%hangulTCount == 0 &&
+        b > hangulTBase && b < hangulTBase+hangulTCount:
+        return a + b - hangulTBase, true
+    }
+    p, ok := nfcTables.composites[[2]rune{a, b}]
+    return p, ok
+}
+
+func combiningClass(r rune) uint8 {
+    if r < 0x300 {
+        return 0
+    }
+    i := sort.Search(len(combiningClasses), func(i int) bool { return combiningClasses[i].hi >= r })
+    if i < len(combiningClasses) && combiningClasses[i].lo <= r {
+        return combiningClasses[i].class
+    }
+    return 0
+}
@@ -0,0 +1,327 @@
+package archive
+
+import (
+    "archive/tar"
+    "errors"
+    "io"
+    "io/ioutil"
+    "os"
+    "path"
+    "sort"
+    "strings"
+    "sync"
+)
+
+// Overlay is a read-only view of a stack of tar or zip archives, the way
+// a container runtime sees its image layers. Upper layers shadow lower
+// ones: a file hides the lower entries of the same name and everything
+// below it, a .wh. whiteout deletes a path from the layers below, and an
+// opaque marker hides the lower contents of its directory. Directories
+// present in several layers are merged.
+//
+// Nothing is extracted. A layer is indexed the first time a lookup
+// reaches it, and content is read from the archive when a file is read,
+// so the archives must stay readable while the overlay is used.
+//
+// Names are slash-separated paths relative to the root, which is "." or
+// "". Symbolic links are reported, not followed.
+type Overlay struct {
+    layers []*overlayLayer // the base first
+    files  []*os.File
+}
+
+// NewOverlay returns an overlay without layers. Add them with Push.
+func NewOverlay() *Overlay { return &Overlay{} }
+
+// OpenOverlay opens the named archive files as the layers of an overlay,
+// the base first. Close closes them.
+func OpenOverlay(names ...string) (*Overlay, error) {
+    o := NewOverlay()
+    for _, name := range names {
+        f, err := os.Open(name)
+        if err != nil {
+            o.Close()
+            return nil, err
+        }
+        o.files = append(o.files, f)
+        fi, err := f.Stat()
+        if err != nil {
+            o.Close()
+            return nil, err
+        }
+        o.Push(f, fi.Size())
+    }
+    return o, nil
+}
+
+// Push adds the archive in r, which is size bytes long, on top of the
+// overlay. Tar and zip archives are read in place; compressed tar, ar and
+// cpio archives are read into memory when the layer is indexed.
+func (o *Overlay) Push(r io.ReaderAt, size int64) {
+    o.layers = append(o.layers, &overlayLayer{r: r, size: size})
+}
+
+// Close closes the files opened by OpenOverlay.
+func (o *Overlay) Close() error {
+    var err error
+    for _, f := range o.files {
+        if cerr := f.Close(); err == nil {
+            err = cerr
+        }
+    }
+    o.files = nil
+    return err
+}
+
+// Stat returns a description of the named file or directory.
+func (o *Overlay) Stat(name string) (os.FileInfo, error) {
+    key, err := overlayKey("stat", name)
+    if err != nil {
+        return nil, err
+    }
+    hdr, _, err := o.lookup(key)
+    if err != nil {
+        return nil, &os.PathError{Op: "stat", Path: name, Err: err}
+    }
+    return hdr.FileInfo(), nil
+}
+
+// Open opens the named file or directory for reading.
+func (o *Overlay) Open(name string) (*OverlayFile, error) {
+    key, err := overlayKey("open", name)
+    if err != nil {
+        return nil, err
+    }
+    hdr, e, err := o.lookup(key)
+    if err != nil {
+        return nil, &os.PathError{Op: "open", Path: name, Err: err}
+    }
+    f := &OverlayFile{name: name, info: hdr.FileInfo()}
+    if hdr.Typeflag == tar.TypeReg || hdr.Typeflag == tar.TypeRegA {
+        if f.rc, err = e.Open(); err != nil {
+            return nil, &os.PathError{Op: "open", Path: name, Err: err}
+        }
+    }
+    return f, nil
+}
+
+// ReadDir returns the entries of the named directory sorted by name.
+func (o *Overlay) ReadDir(name string) ([]os.FileInfo, error) {
+    key, err := overlayKey("readdir", name)
+    if err != nil {
+        return nil, err
+    }
+    hdr, _, err := o.lookup(key)
+    if err == nil && hdr.Typeflag != tar.TypeDir {
+        err = errNotDir
+    }
+    if err != nil {
+        return nil, &os.PathError{Op: "readdir", Path: name, Err: err}
+    }
+    seen := map[string]bool{}
+    var infos []os.FileInfo
+    for i := len(o.layers) - 1; i >= 0; i-- {
+        l := o.layers[i]
+        if err := l.load(); err != nil {
+            return nil, &os.PathError{Op: "readdir", Path: name, Err: err}
+        }
+        for base := range l.children[key] {
+            if seen[base] {
+                continue
+            }
+            seen[base] = true
+            // An upper layer may have deleted or replaced the child.
+            child, _, err := o.lookup(path.Join(key, base))
+            if err == os.ErrNotExist {
+                continue
+            }
+            if err != nil {
+                return nil, &os.PathError{Op: "readdir", Path: name, Err: err}
+            }
+            infos = append(infos, child.FileInfo())
+        }
+        if l.hides(key, true) {
+            break
+        }
+    }
+    sort.Slice(infos, func(i, j int) bool { return infos[i].Name() < infos[j].Name() })
+    return infos, nil
+}
+
+var (
+    errNotDir = errors.New("not a directory")
+    errIsDir  = errors.New("is a directory")
+)
+
+// overlayKey returns the clean name of name, "" for the root.
+func overlayKey(op, name string) (string, error) {
+    clean, err := cleanName(name)
+    if err != nil {
+        return "", &os.PathError{Op: op, Path: name, Err: os.ErrInvalid}
+    }
+    if clean == "." {
+        return "", nil
+    }
+    return clean, nil
+}
+
+// lookup finds the entry visible at key, searching from the top layer
+// down. Directories that only exist as the parents of other entries have
+// a made-up header and no entry.
+func (o *Overlay) lookup(key string) (*tar.Header, *ModelEntry, error) {
+    if key == "" {
+        return &tar.Header{Name: "./", Typeflag: tar.TypeDir, Mode: 0755}, nil, nil
+    }
+    for i := len(o.layers) - 1; i >= 0; i-- {
+        l := o.layers[i]
+        if err := l.load(); err != nil {
+            return nil, nil, err
+        }
+        if e, ok := l.entries[key]; ok {
+            return e.hdr, e, nil
+        }
+        if _, ok := l.children[key]; ok {
+            return &tar.Header{Name: key + "/", Typeflag: tar.TypeDir, Mode: 0755}, nil, nil
+        }
+        if l.hides(key, false) {
+            break
+        }
+    }
+    return nil, nil, os.ErrNotExist
+}
+
+// overlayLayer is the index of one layer.
+type overlayLayer struct {
+    r    io.ReaderAt
+    size int64
+
+    once      sync.Once
+    err       error
+    entries   map[string]*ModelEntry     // by clean name
+    children  map[string]map[string]bool // base names in each directory
+    whiteouts map[string]bool            // paths deleted from lower layers
+    opaque    map[string]bool            // directories whose lower contents are hidden
+}
+
+func (l *overlayLayer) load() error {
+    l.once.Do(func() {
+        m, err := LoadModel(l.r, l.size)
+        if err == ErrFormat {
+            m, err = loadSequentialModel(l.r, l.size)
+        }
+        if err != nil {
+            l.err = err
+            return
+        }
+        l.entries = map[string]*ModelEntry{}
+        l.children = map[string]map[string]bool{"": {}}
+        l.whiteouts = map[string]bool{}
+        l.opaque = map[string]bool{}
+        for _, e := range m.entries {
+            key, err := cleanName(e.hdr.Name)
+            if err != nil || key == "." {
+                continue
+            }
+            dir, base := path.Dir(key), path.Base(key)
+            if dir == "." {
+                dir = ""
+            }
+            switch {
+            case base == WhiteoutOpaque:
+                l.opaque[dir] = true
+                l.addDir(dir)
+            case strings.HasPrefix(base, WhiteoutPrefix):
+                l.whiteouts[path.Join(dir, base[len(WhiteoutPrefix):])] = true
+                l.addDir(dir)
+            default:
+                l.entries[key] = e
+                l.addDir(dir)
+                l.children[dir][base] = true
+                if e.hdr.Typeflag == tar.TypeDir {
+                    l.addDir(key)
+                }
+            }
+        }
+    })
+    return l.err
+}
+
+// addDir records dir and its parents as directories of the layer.
+func (l *overlayLayer) addDir(dir string) {
+    if _, ok := l.children[dir]; ok {
+        return
+    }
+    l.children[dir] = map[string]bool{}
+    parent := path.Dir(dir)
+    if parent == "." {
+        parent = ""
+    }
+    l.addDir(parent)
+    l.children[parent][path.Base(dir)] = true
+}
+
+// hides reports whether the layer hides key from the layers below: it
+// deletes key or one of its parents, replaces one of its parents with
+// something other than a directory, or marks a parent opaque. With
+// contents set, an opaque marker in key itself also counts, since it
+// hides the lower contents of key.
+func (l *overlayLayer) hides(key string, contents bool) bool {
+    if contents && l.opaque[key] {
+        return true
+    }
+    for k := key; k != "" && k != "."; k = path.Dir(k) {
+        if l.whiteouts[k] {
+            return true
+        }
+        if k == key {
+            continue
+        }
+        if l.opaque[k] {
+            return true
+        }
+        if e, ok := l.entries[k]; ok && e.hdr.Typeflag != tar.TypeDir {
+            return true
+        }
+    }
+    return false
+}
+
+// OverlayFile is a file or directory opened from an Overlay.
+type OverlayFile struct {
+    name string
+    info os.FileInfo
+    rc   io.ReadCloser // nil for anything but regular files
+}
+
+// Read reads the content of a regular file. Other entries have none;
+// reading a directory fails.
+func (f *OverlayFile) Read(p []byte) (int, error) {
+    if f.rc == nil {
+        if f.info.IsDir() {
+            return 0, &os.PathError{Op: "read", Path: f.name, Err: errIsDir}
+        }
+        return 0, io.EOF
+    }
+    return f.rc.Read(p)
+}
+
+// Stat returns a description of the file.
+func (f *OverlayFile) Stat() (os.FileInfo, error) { return f.info, nil }
+
+// Close closes the file.
+func (f *OverlayFile) Close() error {
+    if f.rc == nil {
+        return nil
+    }
+    return f.rc.Close()
+}
+
+// ReadFile returns the content of the named file.
+func (o *Overlay) ReadFile(name string) ([]byte, error) {
+    f, err := o.Open(name)
+    if err != nil {
+        return nil, err
+    }
+    defer f.Close()
+    return ioutil.ReadAll(f)
+}
@@ -0,0 +1,121 @@
+package archive
+
+import (
+    "bytes"
+    "io/ioutil"
+    "os"
+    "path/filepath"
+    "reflect"
+    "testing"
+)
+
+func overlayNames(t *testing.T, o *Overlay, dir string) []string {
+    infos, err := o.ReadDir(dir)
+    if err != nil {
+        t.Fatal(err)
+    }
+    var names []string
+    for _, fi := range infos {
+        name := fi.Name()
+        if fi.IsDir() {
+            name += "/"
+        }
+        names = append(names, name)
+    }
+    return names
+}
+
+func TestOverlay(t *testing.T) {
+    base := tarGz(t, map[string]string{
+        "a/x":     "base x",
+        "a/y":     "base y",
+        "b/old":   "old",
+        "c/d/e":   "e",
+        "readme":  "base readme",
+        "keep/me": "kept",
+    })
+    patch := zipBytes(t, map[string][]byte{
+        "a/x":            []byte("patched x"),
+        "a/.wh.y":        nil,
+        "b/.wh..wh..opq": nil,
+        "b/new":          []byte("new"),
+        "c":              []byte("c is a file now"),
+        "readme/notes":   []byte("notes"),
+    })
+    top := zipBytes(t, map[string][]byte{"a/z": []byte("top z")})
+
+    o := NewOverlay()
+    o.Push(bytes.NewReader(base), int64(len(base)))
+    o.Push(bytes.NewReader(patch), int64(len(patch)))
+    o.Push(bytes.NewReader(top), int64(len(top)))
+
+    for name, want := range map[string]string{
+        "a/x":     "patched x",
+        "a/z":     "top z",
+        "b/new":   "new",
+        "c":       "c is a file now",
+        "keep/me": "kept",
+    } {
+        got, err := o.ReadFile(name)
+        if err != nil || string(got) != want {
+            t.Errorf("ReadFile(%q) = %q, %v, want %q", name, got, err, want)
+        }
+    }
+    for _, name := range []string{"a/y", "a/.wh.y", "b/old", "c/d/e", "c/d", "missing"} {
+        if _, err := o.Open(name); !os.IsNotExist(err) {
+            t.Errorf("Open(%q): err = %v, want not exist", name, err)
+        }
+    }
+    if _, err := o.Open("../etc/passwd"); err == nil {
+        t.Error("Open accepted a path outside the root")
+    }
+
+    for dir, want := range map[string][]string{
+        ".": {"a/", "b/", "c", "keep/", "readme/"},
+        "a": {"x", "z"},
+        "b": {"new"},
+    } {
+        if got := overlayNames(t, o, dir); !reflect.DeepEqual(got, want) {
+            t.Errorf("ReadDir(%q) = %q, want %q", dir, got, want)
+        }
+    }
+    if _, err := o.ReadDir("c"); err == nil {
+        t.Error("ReadDir of a file succeeded")
+    }
+
+    f, err := o.Open("a")
+    if err != nil {
+        t.Fatal(err)
+    }
+    if fi, _ := f.Stat(); !fi.IsDir() {
+        t.Errorf("a: mode %v", fi.Mode())
+    }
+    if _, err := f.Read(make([]byte, 1)); err == nil {
+        t.Error("reading a directory succeeded")
+    }
+    f.Close()
+}
+
+func TestOverlayLazy(t *testing.T) {
+    dir := tempDir(t)
+    broken := filepath.Join(dir, "broken.zip")
+    if err := ioutil.WriteFile(broken, []byte("not an archive"), 0644); err != nil {
+        t.Fatal(err)
+    }
+    patch := filepath.Join(dir, "patch.zip")
+    if err := ioutil.WriteFile(patch, zipBytes(t, map[string][]byte{"plugin.so": []byte("elf")}), 0644); err != nil {
+        t.Fatal(err)
+    }
+    o, err := OpenOverlay(broken, patch)
+    if err != nil {
+        t.Fatal(err)
+    }
+    defer o.Close()
+    // The base layer is only read when a lookup gets past the patch.
+    if b, err := o.ReadFile("plugin.so"); err != nil || string(b) != "elf" {
+        t.Errorf("ReadFile = %q, %v", b, err)
+    }
+    if _, err := o.Stat("other"); err == nil || os.IsNotExist(err) {
+        t.Errorf("Stat through a broken layer: err = %v", err)
+    }
+}
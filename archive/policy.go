@@ -0,0 +1,238 @@
+package archive
+
+import (
+    "archive/zip"
+    "bufio"
+    "bytes"
+    "compress/flate"
+    "fmt"
+    "io"
+    "io/ioutil"
+    "net/http"
+    "path"
+    "strings"
+)
+
+// DefaultStoreExtensions lists the extensions of formats that are already
+// compressed, whose entries CompressionPolicy stores without trying.
+var DefaultStoreExtensions = []string{
+    ".7z", ".apk", ".avif", ".br", ".bz2", ".docx", ".epub", ".flac", ".gif",
+    ".gz", ".heic", ".jar", ".jpeg", ".jpg", ".lz4", ".lzma", ".m4a", ".m4v",
+    ".mkv", ".mov", ".mp3", ".mp4", ".odp", ".ods", ".odt", ".ogg", ".opus",
+    ".png", ".pptx", ".rar", ".tgz", ".webm", ".webp", ".whl", ".woff",
+    ".woff2", ".xlsx", ".xz", ".zip", ".zst",
+}
+
+// compressedTypes are the sniffed content types of compressed formats.
+var compressedTypes = map[string]bool{
+    "image/gif":                    true,
+    "image/jpeg":                   true,
+    "image/png":                    true,
+    "image/webp":                   true,
+    "audio/mpeg":                   true,
+    "application/ogg":              true,
+    "video/mp4":                    true,
+    "video/webm":                   true,
+    "font/woff":                    true,
+    "font/woff2":                   true,
+    "application/zip":              true,
+    "application/x-gzip":           true,
+    "application/x-rar-compressed": true,
+}
+
+// compressedMagic are signatures of compressed formats that
+// http.DetectContentType does not know.
+var compressedMagic = []struct {
+    magic string
+    typ   string
+}{
+    {"BZh", "application/x-bzip2"},
+    {"\xfd7zXZ\x00", "application/x-xz"},
+    {"\x28\xb5\x2f\xfd", "application/zstd"},
+    {"7z\xbc\xaf\x27\x1c", "application/x-7z-compressed"},
+    {"\x04\x22\x4d\x18", "application/x-lz4"},
+}
+
+// CompressionPolicy chooses between Store and Deflate for each entry. An
+// entry is stored if it is empty, if its extension or sniffed content
+// type is that of a compressed format, or if deflating a sample of it
+// saves too little. The zero value is ready to use.
+type CompressionPolicy struct {
+    // StoreExtensions lists lower case extensions, with the dot, that
+    // are always stored. Nil means DefaultStoreExtensions.
+    StoreExtensions []string
+    // SampleSize is the number of leading bytes trial compressed. Zero
+    // means 64 KiB.
+    SampleSize int
+    // MaxRatio is the largest compressed to original size ratio of the
+    // sample for which the entry is deflated. Zero means 0.9.
+    MaxRatio float64
+    // Level is the flate level used for deflated entries and trials.
+    // Zero means flate.BestCompression.
+    Level int
+    // Report, if set, is called with each decision.
+    Report func(MethodDecision)
+}
+
+// MethodDecision records the method chosen for an entry and why.
+type MethodDecision struct {
+    Name   string
+    Method uint16  // zip.Store or zip.Deflate
+    Reason string  // for example "extension .png" or "sample ratio 0.98"
+    Ratio  float64 // compressed to original size of the sample, if one was tried
+}
+
+func (d MethodDecision) String() string {
+    m := "deflate"
+    if d.Method == zip.Store {
+        m = "store"
+    }
+    return fmt.Sprintf("%s: %s (%s)", d.Name, m, d.Reason)
+}
+
+// defaultSampleSize is the sample size of a policy without SampleSize.
+const defaultSampleSize = 64 << 10
+
+func (p *CompressionPolicy) sampleSize() int {
+    if p.SampleSize > 0 {
+        return p.SampleSize
+    }
+    return defaultSampleSize
+}
+
+func (p *CompressionPolicy) level() int {
+    if p.Level != 0 {
+        return p.Level
+    }
+    return flate.BestCompression
+}
+
+// Decide chooses the method for the entry name given a sample of its
+// leading content. A sample shorter than the policy's SampleSize is
+// taken to be the whole content.
+func (p *CompressionPolicy) Decide(name string, sample []byte) MethodDecision {
+    d := p.decide(name, sample)
+    if p.Report != nil {
+        p.Report(d)
+    }
+    return d
+}
+
+func (p *CompressionPolicy) decide(name string, sample []byte) MethodDecision {
+    d := MethodDecision{Name: name, Method: zip.Store}
+    if len(sample) > p.sampleSize() {
+        sample = sample[:p.sampleSize()]
+    }
+    if len(sample) == 0 {
+        d.Reason = "empty"
+        return d
+    }
+    exts := p.StoreExtensions
+    if exts == nil {
+        exts = DefaultStoreExtensions
+    }
+    ext := strings.ToLower(path.Ext(name))
+    for _, e := range exts {
+        if ext == e {
+            d.Reason = "extension " + ext
+            return d
+        }
+    }
+    if typ := sniffCompressed(sample); typ != "" {
+        d.Reason = "content type " + typ
+        return d
+    }
+    buf := &countWriter{w: ioutil.Discard}
+    fw, err := flate.NewWriter(buf, p.level())
+    if err != nil {
+        d.Reason = err.Error()
+        return d
+    }
+    fw.Write(sample)
+    fw.Close()
+    d.Ratio = float64(buf.n) / float64(len(sample))
+    d.Reason = fmt.Sprintf("sample ratio %.2f", d.Ratio)
+    max := p.MaxRatio
+    if max == 0 {
+        max = 0.9
+    }
+    if d.Ratio <= max {
+        d.Method = zip.Deflate
+    }
+    return d
+}
+
+// sniffCompressed returns the content type of data if it is a compressed
+// format, or "".
+func sniffCompressed(data []byte) string {
+    if typ := http.DetectContentType(data); compressedTypes[typ] {
+        return typ
+    }
+    for _, m := range compressedMagic {
+        if bytes.HasPrefix(data, []byte(m.magic)) {
+            return m.typ
+        }
+    }
+    return ""
+}
+
+// PolicyWriter writes a zip archive, choosing the compression method of
+// each entry with a CompressionPolicy.
+type PolicyWriter struct {
+    zw        *zip.Writer
+    policy    *CompressionPolicy
+    decisions []MethodDecision
+}
+
+// NewPolicyWriter returns a PolicyWriter writing to w. A nil policy means
+// the zero CompressionPolicy.
+func NewPolicyWriter(w io.Writer, policy *CompressionPolicy) *PolicyWriter {
+    if policy == nil {
+        policy = &CompressionPolicy{}
+    }
+    zw := newZipWriter(w)
+    level := policy.level()
+    zw.RegisterCompressor(zip.Deflate, func(out io.Writer) (io.WriteCloser, error) {
+        return flate.NewWriter(out, level)
+    })
+    return &PolicyWriter{zw: zw, policy: policy}
+}
+
+// Add writes an entry with the content read from r. The method in fh is
+// replaced by the policy's choice for regular files; directories and
+// other entries without content are written as given.
+func (w *PolicyWriter) Add(fh *zip.FileHeader, r io.Reader) error {
+    setUTF8Flag(fh)
+    setZipModified(fh, fh.Modified)
+    if !fh.Mode().IsRegular() {
+        fw, err := w.zw.CreateHeader(fh)
+        if err != nil {
+            return err
+        }
+        _, err = copyBuffer(fw, r)
+        return err
+    }
+    br := bufio.NewReaderSize(r, w.policy.sampleSize())
+    sample, err := br.Peek(w.policy.sampleSize())
+    if err != nil && err != io.EOF && err != bufio.ErrBufferFull {
+        return err
+    }
+    d := w.policy.Decide(fh.Name, sample)
+    w.decisions = append(w.decisions, d)
+    fh.Method = d.Method
+    fw, err := w.zw.CreateHeader(fh)
+    if err != nil {
+        return err
+    }
+    _, err = copyBuffer(fw, br)
+    return err
+}
+
+// Decisions returns the decisions made so far, in entry order.
+func (w *PolicyWriter) Decisions() []MethodDecision { return w.decisions }
+
+// SetComment sets the archive comment.
+func (w *PolicyWriter) SetComment(comment string) error { return w.zw.SetComment(comment) }
+
+// Close finishes the archive. It does not close the underlying writer.
+func (w *PolicyWriter) Close() error { return w.zw.Close() }
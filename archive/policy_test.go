@@ -0,0 +1,119 @@
+package archive
+
+import (
+    "archive/zip"
+    "bytes"
+    "context"
+    "io/ioutil"
+    "math/rand"
+    "path/filepath"
+    "strings"
+    "testing"
+)
+
+func policyInputs() map[string][]byte {
+    random := make([]byte, 100<<10)
+    rand.New(rand.NewSource(1)).Read(random)
+    return map[string][]byte{
+        "notes.txt":  []byte(strings.Repeat("Gopher names:\nGeorge\nGeoffrey\nGonzo\n", 100)),
+        "photo.PNG":  []byte(strings.Repeat("not really a png ", 100)),
+        "sniffed":    append([]byte("\x89PNG\x0d\x0a\x1a\x0a"), random[:1000]...),
+        "random.bin": random,
+        "empty.txt":  nil,
+    }
+}
+
+var policyWant = map[string]struct {
+    method uint16
+    reason string
+}{
+    "notes.txt":  {zip.Deflate, "sample ratio"},
+    "photo.PNG":  {zip.Store, "extension .png"},
+    "sniffed":    {zip.Store, "content type image/png"},
+    "random.bin": {zip.Store, "sample ratio"},
+    "empty.txt":  {zip.Store, "empty"},
+}
+
+func TestCompressionPolicyDecide(t *testing.T) {
+    var reported []MethodDecision
+    p := &CompressionPolicy{Report: func(d MethodDecision) { reported = append(reported, d) }}
+    for name, data := range policyInputs() {
+        d := p.Decide(name, data)
+        want := policyWant[name]
+        if d.Method != want.method || !strings.HasPrefix(d.Reason, want.reason) {
+            t.Errorf("%s: got %v, want method %d (%s)", name, d, want.method, want.reason)
+        }
+    }
+    if len(reported) != len(policyWant) {
+        t.Errorf("reported %d decisions, want %d", len(reported), len(policyWant))
+    }
+}
+
+func TestPolicyWriter(t *testing.T) {
+    inputs := policyInputs()
+    var buf bytes.Buffer
+    w := NewPolicyWriter(&buf, nil)
+    for name, data := range inputs {
+        if err := w.Add(&zip.FileHeader{Name: name}, bytes.NewReader(data)); err != nil {
+            t.Fatal(err)
+        }
+    }
+    if err := w.Add(&zip.FileHeader{Name: "dir/"}, bytes.NewReader(nil)); err != nil {
+        t.Fatal(err)
+    }
+    if err := w.Close(); err != nil {
+        t.Fatal(err)
+    }
+    if len(w.Decisions()) != len(inputs) {
+        t.Errorf("%d decisions, want %d", len(w.Decisions()), len(inputs))
+    }
+    zr, err := zip.NewReader(bytes.NewReader(buf.Bytes()), int64(buf.Len()))
+    if err != nil {
+        t.Fatal(err)
+    }
+    for _, f := range zr.File {
+        if f.Name == "dir/" {
+            continue
+        }
+        if f.Method != policyWant[f.Name].method {
+            t.Errorf("%s: method %d, want %d", f.Name, f.Method, policyWant[f.Name].method)
+        }
+        rc, err := f.Open()
+        if err != nil {
+            t.Fatal(err)
+        }
+        b, err := ioutil.ReadAll(rc)
+        rc.Close()
+        if err != nil || !bytes.Equal(b, inputs[f.Name]) {
+            t.Errorf("%s: content mismatch: %v", f.Name, err)
+        }
+    }
+}
+
+func TestWriteZipCompressionPolicy(t *testing.T) {
+    src := tempDir(t)
+    files := map[string]string{}
+    for name, data := range policyInputs() {
+        files[name] = string(data)
+    }
+    writeTree(t, src, files)
+    out := filepath.Join(tempDir(t), "out.zip")
+    var decisions int
+    opts := &CreateOptions{Compression: &CompressionPolicy{Report: func(MethodDecision) { decisions++ }}}
+    if err := Create(context.Background(), out, src, opts); err != nil {
+        t.Fatal(err)
+    }
+    zr, err := zip.OpenReader(out)
+    if err != nil {
+        t.Fatal(err)
+    }
+    defer zr.Close()
+    for _, f := range zr.File {
+        if f.Method != policyWant[f.Name].method {
+            t.Errorf("%s: method %d, want %d", f.Name, f.Method, policyWant[f.Name].method)
+        }
+    }
+    if decisions != len(files) {
+        t.Errorf("%d decisions, want %d", decisions, len(files))
+    }
+}
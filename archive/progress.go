@@ -0,0 +1,183 @@
+package archive
+
+import (
+    "context"
+    "io"
+    "sync"
+    "time"
+)
+
+// Progress is a snapshot of a running archive operation.
+type Progress struct {
+    Entries      int           // entries finished so far
+    TotalEntries int           // total entries, or -1 when unknown
+    BytesIn      int64         // bytes consumed from the source
+    BytesOut     int64         // bytes written to the destination
+    TotalBytes   int64         // expected BytesIn at completion, or -1 when unknown
+    Elapsed      time.Duration // time since the operation started
+    ETA          time.Duration // estimated time remaining, or -1 when unknown
+    Current      string        // name of the entry being processed
+    Done         bool          // set on the final report
+}
+
+// ProgressFunc receives progress reports. It is called from the goroutine
+// running the operation, so it should return quickly.
+type ProgressFunc func(Progress)
+
+// ProgressChan returns a ProgressFunc that sends reports on ch.
+// Reports are dropped while ch is full, except the final one.
+func ProgressChan(ch chan<- Progress) ProgressFunc {
+    return func(p Progress) {
+        if p.Done {
+            ch <- p
+            return
+        }
+        select {
+        case ch <- p:
+        default:
+        }
+    }
+}
+
+// progressInterval limits how often reports are sent while copying data.
+const progressInterval = 100 * time.Millisecond
+
+// tracker accumulates counters for one operation and forwards them to a
+// ProgressFunc. It is safe for concurrent use.
+type tracker struct {
+    mu    sync.Mutex
+    fn    ProgressFunc
+    p     Progress
+    start time.Time
+    last  time.Time
+}
+
+func newTracker(fn ProgressFunc, totalEntries int, totalBytes int64) *tracker {
+    now := time.Now()
+    return &tracker{
+        fn:    fn,
+        start: now,
+        last:  now,
+        p: Progress{
+            TotalEntries: totalEntries,
+            TotalBytes:   totalBytes,
+            ETA:          -1,
+        },
+    }
+}
+
+func (t *tracker) begin(name string) {
+    t.mu.Lock()
+    t.p.Current = name
+    t.mu.Unlock()
+}
+
+func (t *tracker) addIn(n int64) {
+    t.mu.Lock()
+    t.p.BytesIn += n
+    t.reportLocked(false)
+    t.mu.Unlock()
+}
+
+func (t *tracker) addOut(n int64) {
+    t.mu.Lock()
+    t.p.BytesOut += n
+    t.reportLocked(false)
+    t.mu.Unlock()
+}
+
+func (t *tracker) entryDone() {
+    t.mu.Lock()
+    t.p.Entries++
+    t.reportLocked(true)
+    t.mu.Unlock()
+}
+
+func (t *tracker) done() {
+    t.mu.Lock()
+    t.p.Done = true
+    t.p.Current = ""
+    t.reportLocked(true)
+    t.mu.Unlock()
+}
+
+func (t *tracker) reportLocked(force bool) {
+    if t.fn == nil {
+        return
+    }
+    now := time.Now()
+    if !force && now.Sub(t.last) < progressInterval {
+        return
+    }
+    t.last = now
+    t.p.Elapsed = now.Sub(t.start)
+    t.p.ETA = -1
+    switch {
+    case t.p.Done:
+        t.p.ETA = 0
+    case t.p.TotalBytes > 0 && t.p.BytesIn > 0:
+        left := t.p.TotalBytes - t.p.BytesIn
+        if left < 0 {
+            left = 0
+        }
+        t.p.ETA = time.Duration(float64(t.p.Elapsed) * float64(left) / float64(t.p.BytesIn))
+    case t.p.TotalEntries > 0 && t.p.Entries > 0:
+        left := t.p.TotalEntries - t.p.Entries
+        t.p.ETA = t.p.Elapsed * time.Duration(left) / time.Duration(t.p.Entries)
+    }
+    t.fn(t.p)
+}
+
+// ctxReader fails reads once ctx is cancelled and counts the bytes read.
+type ctxReader struct {
+    ctx   context.Context
+    r     io.Reader
+    count func(int64)
+}
+
+func (r *ctxReader) Read(p []byte) (int, error) {
+    if err := r.ctx.Err(); err != nil {
+        return 0, err
+    }
+    n, err := r.r.Read(p)
+    if n > 0 && r.count != nil {
+        r.count(int64(n))
+    }
+    return n, err
+}
+
+// ctxWriter fails writes once ctx is cancelled and counts the bytes written.
+type ctxWriter struct {
+    ctx   context.Context
+    w     io.Writer
+    count func(int64)
+}
+
+func (w *ctxWriter) Write(p []byte) (int, error) {
+    if err := w.ctx.Err(); err != nil {
+        return 0, err
+    }
+    n, err := w.w.Write(p)
+    if n > 0 && w.count != nil {
+        w.count(int64(n))
+    }
+    return n, err
+}
+
+// countReaderAt counts the bytes read through ReadAt.
+type countReaderAt struct {
+    ctx   context.Context
+    r     io.ReaderAt
+    count func(int64)
+}
+
+func (r *countReaderAt) ReadAt(p []byte, off int64) (int, error) {
+    if err := r.ctx.Err(); err != nil {
+        return 0, err
+    }
+    n, err := r.r.ReadAt(p, off)
+    if n > 0 && r.count != nil {
+        r.count(int64(n))
+    }
+    return n, err
+}
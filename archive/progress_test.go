@@ -0,0 +1,28 @@
+package archive
+
+import (
+    "bytes"
+    "context"
+    "testing"
+)
+
+func TestProgressReports(t *testing.T) {
+    src := tempDir(t)
+    writeTree(t, src, treeFiles)
+    ch := make(chan Progress, 64)
+    var buf bytes.Buffer
+    if err := WriteTar(context.Background(), &buf, src, &CreateOptions{Progress: ProgressChan(ch)}); err != nil {
+        t.Fatal(err)
+    }
+    close(ch)
+    var last Progress
+    for p := range ch {
+        last = p
+    }
+    if !last.Done || last.Entries != 5 || last.TotalEntries != 5 {
+        t.Fatalf("final report = %+v", last)
+    }
+    if last.BytesIn != last.TotalBytes || last.BytesOut != int64(buf.Len()) || last.ETA != 0 {
+        t.Fatalf("final counters = %+v, archive is %d bytes", last, buf.Len())
+    }
+}
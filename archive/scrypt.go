@@ -0,0 +1,135 @@
+package archive
+
+import (
+    "crypto/hmac"
+    "crypto/sha256"
+    "encoding/binary"
+    "errors"
+    "math/bits"
+)
+
+// scrypt derives a key of keyLen bytes from password and salt as in RFC
+// 7914, with cost n, a power of two, block size r and parallelism p.
+func scrypt(password, salt []byte, n, r, p, keyLen int) ([]byte, error) {
+    if n < 2 || n&(n-1) != 0 {
+        return nil, errors.New("archive: scrypt cost must be a power of two above 1")
+    }
+    if r < 1 || p < 1 || uint64(r)*uint64(p) >= 1<<30 || r > (1<<31-1)/128/p || n > (1<<31-1)/128/r {
+        return nil, errors.New("archive: scrypt parameters too large")
+    }
+    b := pbkdf2SHA256(password, salt, p*128*r)
+    x := make([]uint32, 32*r)
+    v := make([]uint32, 32*r*n)
+    y := make([]uint32, 32*r)
+    for i := 0; i < p; i++ {
+        roMix(b[i*128*r:(i+1)*128*r], x, y, v, n, r)
+    }
+    return pbkdf2SHA256(password, b, keyLen), nil
+}
+
+// roMix mixes the block b in place with the scratch space x, y and v.
+func roMix(b []byte, x, y, v []uint32, n, r int) {
+    for i := range x {
+        x[i] = binary.LittleEndian.Uint32(b[4*i:])
+    }
+    words := 32 * r
+    for i := 0; i < n; i++ {
+        copy(v[i*words:], x)
+        blockMix(x, y, r)
+    }
+    for i := 0; i < n; i++ {
+        j := int(x[(2*r-1)*16] & uint32(n-1))
+        for k, w := range v[j*words : (j+1)*words] {
+            x[k] ^= w
+        }
+        blockMix(x, y, r)
+    }
+    for i, w := range x {
+        binary.LittleEndian.PutUint32(b[4*i:], w)
+    }
+}
+
+// blockMix applies BlockMix with Salsa20/8 to b, using y as scratch.
+func blockMix(b, y []uint32, r int) {
+    var t [16]uint32
+    copy(t[:], b[(2*r-1)*16:])
+    for i := 0; i < 2*r; i++ {
+        for k := range t {
+            t[k] ^= b[i*16+k]
+        }
+        salsa8(&t)
+        // Even blocks go to the first half of the output, odd ones to
+        // the second.
+        copy(y[(i/2+(i&1)*r)*16:], t[:])
+    }
+    copy(b, y)
+}
+
+// salsa8 applies the Salsa20/8 core to x.
+func salsa8(x *[16]uint32) {
+    w := *x
+    for i := 0; i < 8; i += 2 {
+        w[4] ^= bits.RotateLeft32(w[0]+w[12], 7)
+        w[8] ^= bits.RotateLeft32(w[4]+w[0], 9)
+        w[12] ^= bits.RotateLeft32(w[8]+w[4], 13)
+        w[0] ^= bits.RotateLeft32(w[12]+w[8], 18)
+        w[9] ^= bits.RotateLeft32(w[5]+w[1], 7)
+        w[13] ^= bits.RotateLeft32(w[9]+w[5], 9)
+        w[1] ^= bits.RotateLeft32(w[13]+w[9], 13)
+        w[5] ^= bits.RotateLeft32(w[1]+w[13], 18)
+        w[14] ^= bits.RotateLeft32(w[10]+w[6], 7)
+        w[2] ^= bits.RotateLeft32(w[14]+w[10], 9)
+        w[6] ^= bits.RotateLeft32(w[2]+w[14], 13)
+        w[10] ^= bits.RotateLeft32(w[6]+w[2], 18)
+        w[3] ^= bits.RotateLeft32(w[15]+w[11], 7)
+        w[7] ^= bits.RotateLeft32(w[3]+w[15], 9)
+        w[11] ^= bits.RotateLeft32(w[7]+w[3], 13)
+        w[15] ^= bits.RotateLeft32(w[11]+w[7], 18)
+        w[1] ^= bits.RotateLeft32(w[0]+w[3], 7)
+        w[2] ^= bits.RotateLeft32(w[1]+w[0], 9)
+        w[3] ^= bits.RotateLeft32(w[2]+w[1], 13)
+        w[0] ^= bits.RotateLeft32(w[3]+w[2], 18)
+        w[6] ^= bits.RotateLeft32(w[5]+w[4], 7)
+        w[7] ^= bits.RotateLeft32(w[6]+w[5], 9)
+        w[4] ^= bits.RotateLeft32(w[7]+w[6], 13)
+        w[5] ^= bits.RotateLeft32(w[4]+w[7], 18)
+        w[11] ^= bits.RotateLeft32(w[10]+w[9], 7)
+        w[8] ^= bits.RotateLeft32(w[11]+w[10], 9)
+        w[9] ^= bits.RotateLeft32(w[8]+w[11], 13)
+        w[10] ^= bits.RotateLeft32(w[9]+w[8], 18)
+        w[12] ^= bits.RotateLeft32(w[15]+w[14], 7)
+        w[13] ^= bits.RotateLeft32(w[12]+w[15], 9)
+        w[14] ^= bits.RotateLeft32(w[13]+w[12], 13)
+        w[15] ^= bits.RotateLeft32(w[14]+w[13], 18)
+    }
+    for i := range x {
+        x[i] += w[i]
+    }
+}
+
+// pbkdf2SHA256 is PBKDF2 with HMAC-SHA256 and a single iteration, all
+// that scrypt needs.
+func pbkdf2SHA256(password, salt []byte, keyLen int) []byte {
+    prf := hmac.New(sha256.New, password)
+    var key []byte
+    var ctr [4]byte
+    for i := uint32(1); len(key) < keyLen; i++ {
+        prf.Reset()
+        prf.Write(salt)
+        binary.BigEndian.PutUint32(ctr[:], i)
+        prf.Write(ctr[:])
+        key = prf.Sum(key)
+    }
+    return key[:keyLen]
+}
+
+// hkdfSHA256 derives a key of keyLen bytes, at most 32, from secret as in
+// RFC 5869.
+func hkdfSHA256(secret, salt, info []byte, keyLen int) []byte {
+    extract := hmac.New(sha256.New, salt)
+    extract.Write(secret)
+    expand := hmac.New(sha256.New, extract.Sum(nil))
+    expand.Write(info)
+    expand.Write([]byte{1})
+    return expand.Sum(nil)[:keyLen]
+}
@@ -0,0 +1,239 @@
+package archive
+
+import (
+    "archive/tar"
+    "bufio"
+    "bytes"
+    "compress/bzip2"
+    "compress/gzip"
+    "context"
+    "fmt"
+    "io"
+    "os"
+    "regexp"
+    "strings"
+)
+
+// DefaultSearchDepth is the number of nested archive levels Search enters
+// when SearchOptions.MaxDepth is zero.
+const DefaultSearchDepth = 8
+
+// maxSearchLayers bounds how many compression layers are peeled off one
+// member, such as the gzip around a tar.
+const maxSearchLayers = 4
+
+// maxSearchLine is the longest line reported whole; longer lines are
+// searched in pieces of this size.
+const maxSearchLine = 64 << 10
+
+// SearchOptions configures Search.
+type SearchOptions struct {
+    // MaxDepth limits how many levels of archives nested inside the
+    // searched one are entered. Zero means DefaultSearchDepth, and a
+    // negative value searches nested archives as plain data.
+    MaxDepth int
+    // OnError, if set, is called for members that cannot be read, and
+    // the search goes on. Otherwise the first such error ends it.
+    OnError func(path []string, err error)
+}
+
+// SearchMatch is a line matching the pattern of a search.
+type SearchMatch struct {
+    // Path is the chain of names leading to the member: the searched
+    // archive, then members of it and of any nested archives.
+    Path []string
+    Line int // line number, counting from one
+    Text string
+}
+
+// Location returns the path chain and line number in the form
+// bundle.zip!/node1.tar.gz!/var/log/app.log:123.
+func (m SearchMatch) Location() string {
+    return fmt.Sprintf("%s:%d", strings.Join(m.Path, "!/"), m.Line)
+}
+
+func (m SearchMatch) String() string { return m.Location() + ": " + m.Text }
+
+// Search streams through the archive read from r, named name, and calls fn
+// with each line of its members that matches re, as soon as it is found.
+// Gzip, bzip2 and zstd compression is removed, and tar, zip, ar and cpio
+// archives found inside are searched in turn. If r is not an archive its
+// content is searched directly. An error returned by fn ends the search
+// and is returned.
+func Search(ctx context.Context, name string, r io.Reader, re *regexp.Regexp, opts *SearchOptions, fn func(SearchMatch) error) error {
+    if opts == nil {
+        opts = &SearchOptions{}
+    }
+    s := &searcher{ctx: ctx, re: re, opts: opts, fn: fn, maxDepth: opts.MaxDepth}
+    if s.maxDepth == 0 {
+        s.maxDepth = DefaultSearchDepth
+    }
+    err := s.member([]string{name}, r, 0, 0)
+    if stop, ok := err.(errStop); ok {
+        return stop.err
+    }
+    return err
+}
+
+// SearchFile searches the named file as Search does.
+func SearchFile(ctx context.Context, name string, re *regexp.Regexp, opts *SearchOptions, fn func(SearchMatch) error) error {
+    f, err := os.Open(name)
+    if err != nil {
+        return err
+    }
+    defer f.Close()
+    return Search(ctx, name, f, re, opts, fn)
+}
+
+type searcher struct {
+    ctx      context.Context
+    re       *regexp.Regexp
+    opts     *SearchOptions
+    fn       func(SearchMatch) error
+    maxDepth int
+}
+
+// errStop wraps errors that end the search, as opposed to errors reading
+// a member, which OnError may absorb.
+type errStop struct{ err error }
+
+func (e errStop) Error() string { return e.err.Error() }
+
+// fail handles an error reading the member at path.
+func (s *searcher) fail(path []string, err error) error {
+    if stop, ok := err.(errStop); ok {
+        return stop
+    }
+    if s.opts.OnError == nil {
+        return errStop{fmt.Errorf("%s: %w", strings.Join(path, "!/"), err)}
+    }
+    s.opts.OnError(append([]string(nil), path...), err)
+    return nil
+}
+
+// member searches the content of the member at path, whose archive nesting
+// depth is depth and from which layers compression layers were removed.
+func (s *searcher) member(path []string, r io.Reader, depth, layers int) error {
+    br := bufio.NewReaderSize(r, 64<<10)
+    head, _ := br.Peek(512)
+    if layers < maxSearchLayers {
+        dr, err := decompressLayer(br, head)
+        if err != nil {
+            return s.fail(path, err)
+        }
+        if dr != nil {
+            return s.member(path, dr, depth, layers+1)
+        }
+    }
+    if depth == 0 || depth <= s.maxDepth {
+        if er := sequentialEntries(br, head); er != nil {
+            return s.entries(path, er, depth)
+        }
+    }
+    if err := s.grep(path, br); err != nil {
+        return s.fail(path, err)
+    }
+    return nil
+}
+
+// decompressLayer returns a reader for the content of br if it is gzip,
+// bzip2 or zstd compressed, judging by its leading bytes head, or nil.
+func decompressLayer(br *bufio.Reader, head []byte) (io.Reader, error) {
+    switch {
+    case bytes.HasPrefix(head, []byte("\x1f\x8b")):
+        return gzip.NewReader(br)
+    case bytes.HasPrefix(head, []byte("BZh")) && len(head) > 3 && head[3] >= '1' && head[3] <= '9':
+        return bzip2.NewReader(br), nil
+    case bytes.HasPrefix(head, []byte("\x28\xb5\x2f\xfd")):
+        return newZstdReader(br), nil
+    }
+    return nil, nil
+}
+
+// sequentialEntries returns an EntryReader for br if its leading bytes
+// head start a zip, tar, ar or cpio archive, or nil.
+func sequentialEntries(br *bufio.Reader, head []byte) EntryReader {
+    switch {
+    case bytes.HasPrefix(head, []byte("PK\x03\x04")), bytes.HasPrefix(head, []byte("PK\x05\x06")):
+        return zipEntries{NewZipStreamReader(br)}
+    case len(head) >= 263 && bytes.HasPrefix(head[257:], []byte("ustar")):
+        return tar.NewReader(br)
+    case bytes.HasPrefix(head, []byte(arMagic)):
+        return NewArReader(br)
+    case bytes.HasPrefix(head, []byte("07070")):
+        return NewCpioReader(br)
+    }
+    return nil
+}
+
+// entries searches the regular files of an archive.
+func (s *searcher) entries(path []string, er EntryReader, depth int) error {
+    for {
+        if err := s.ctx.Err(); err != nil {
+            return errStop{err}
+        }
+        hdr, err := er.Next()
+        if err == io.EOF {
+            return nil
+        }
+        if err != nil {
+            // The rest of the archive cannot be reached.
+            return s.fail(path, err)
+        }
+        if hdr.Typeflag != tar.TypeReg && hdr.Typeflag != tar.TypeRegA {
+            continue
+        }
+        if err := s.member(append(path[:len(path):len(path)], hdr.Name), er, depth+1, 0); err != nil {
+            return err
+        }
+    }
+}
+
+// grep reports the lines of r matching the pattern.
+func (s *searcher) grep(path []string, r *bufio.Reader) error {
+    var line []byte
+    for n := 1; ; {
+        chunk, err := r.ReadSlice('\n')
+        line = append(line, chunk...)
+        if err == bufio.ErrBufferFull && len(line) < maxSearchLine {
+            continue
+        }
+        if len(line) > 0 {
+            text := bytes.TrimRight(line, "\r\n")
+            if s.re.Match(text) {
+                m := SearchMatch{Path: append([]string(nil), path...), Line: n, Text: string(text)}
+                if err := s.fn(m); err != nil {
+                    return errStop{err}
+                }
+            }
+            if line[len(line)-1] == '\n' {
+                n++
+                if n%4096 == 0 {
+                    if err := s.ctx.Err(); err != nil {
+                        return errStop{err}
+                    }
+                }
+            }
+        }
+        line = line[:0]
+        if err == io.EOF {
+            return nil
+        }
+        if err != nil && err != bufio.ErrBufferFull {
+            return err
+        }
+    }
+}
+
+// zipEntries presents a ZipStreamReader as an EntryReader.
+type zipEntries struct{ z *ZipStreamReader }
+
+func (z zipEntries) Next() (*tar.Header, error) {
+    fh, err := z.z.Next()
+    if err != nil {
+        return nil, err
+    }
+    return zipHeader(fh), nil
+}
+
+func (z zipEntries) Read(p []byte) (int, error) { return z.z.Read(p) }
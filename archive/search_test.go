@@ -0,0 +1,139 @@
+package archive
+
+import (
+    "archive/tar"
+    "archive/zip"
+    "bytes"
+    "compress/gzip"
+    "context"
+    "errors"
+    "fmt"
+    "regexp"
+    "strings"
+    "testing"
+)
+
+// logLines returns n log lines, with an error on the lines listed.
+func logLines(n int, errs ...int) string {
+    var b strings.Builder
+    for i := 1; i <= n; i++ {
+        level := "INFO"
+        for _, e := range errs {
+            if e == i {
+                level = "ERROR"
+            }
+        }
+        fmt.Fprintf(&b, "%s line %d\n", level, i)
+    }
+    return b.String()
+}
+
+func tarGz(t *testing.T, files map[string]string) []byte {
+    var buf bytes.Buffer
+    gz := gzip.NewWriter(&buf)
+    tw := tar.NewWriter(gz)
+    for name, body := range files {
+        if err := tw.WriteHeader(&tar.Header{Name: name, Mode: 0644, Size: int64(len(body)), Typeflag: tar.TypeReg}); err != nil {
+            t.Fatal(err)
+        }
+        tw.Write([]byte(body))
+    }
+    tw.Close()
+    gz.Close()
+    return buf.Bytes()
+}
+
+func zipBytes(t *testing.T, files map[string][]byte) []byte {
+    var buf bytes.Buffer
+    zw := zip.NewWriter(&buf)
+    for name, body := range files {
+        fw, err := zw.Create(name)
+        if err != nil {
+            t.Fatal(err)
+        }
+        fw.Write(body)
+    }
+    if err := zw.Close(); err != nil {
+        t.Fatal(err)
+    }
+    return buf.Bytes()
+}
+
+func bundle(t *testing.T) []byte {
+    inner := zipBytes(t, map[string][]byte{"deep.log": []byte(logLines(5, 2))})
+    return zipBytes(t, map[string][]byte{
+        "node1.tar.gz": tarGz(t, map[string]string{
+            "var/log/app.log": logLines(200, 123),
+            "var/log/old.zip": string(inner),
+        }),
+        "node2.log": []byte(logLines(10, 7)),
+    })
+}
+
+func search(t *testing.T, data []byte, opts *SearchOptions) []string {
+    var got []string
+    err := Search(context.Background(), "bundle.zip", bytes.NewReader(data), regexp.MustCompile("ERROR"), opts,
+        func(m SearchMatch) error {
+            got = append(got, m.Location())
+            return nil
+        })
+    if err != nil {
+        t.Fatal(err)
+    }
+    return got
+}
+
+func TestSearchNested(t *testing.T) {
+    got := strings.Join(search(t, bundle(t), nil), ",")
+    for _, want := range []string{
+        "bundle.zip!/node1.tar.gz!/var/log/app.log:123",
+        "bundle.zip!/node1.tar.gz!/var/log/old.zip!/deep.log:2",
+        "bundle.zip!/node2.log:7",
+    } {
+        if !strings.Contains(got, want) {
+            t.Errorf("missing %s in %s", want, got)
+        }
+    }
+    if n := strings.Count(got, ",") + 1; n != 3 {
+        t.Errorf("%d matches: %s", n, got)
+    }
+}
+
+func TestSearchDepth(t *testing.T) {
+    // With one nested level the zip inside the tar is searched as data,
+    // where its deflated content does not match.
+    got := strings.Join(search(t, bundle(t), &SearchOptions{MaxDepth: 1}), ",")
+    if strings.Contains(got, "deep.log") || !strings.Contains(got, "app.log:123") {
+        t.Errorf("got %s", got)
+    }
+}
+
+func TestSearchStop(t *testing.T) {
+    stop := errors.New("stop")
+    n := 0
+    err := Search(context.Background(), "bundle.zip", bytes.NewReader(bundle(t)), regexp.MustCompile("line"), nil,
+        func(SearchMatch) error {
+            n++
+            return stop
+        })
+    if err != stop || n != 1 {
+        t.Errorf("got %v after %d matches", err, n)
+    }
+}
+
+func TestSearchOnError(t *testing.T) {
+    var failed []string
+    opts := &SearchOptions{OnError: func(path []string, err error) {
+        failed = append(failed, strings.Join(path, "!/"))
+    }}
+    got := search(t, unsupportedZip(t), opts)
+    if len(got) != 0 || len(failed) != 1 || failed[0] != "bundle.zip!/ppmd.bin" {
+        t.Errorf("matches %v, failures %v", got, failed)
+    }
+    err := Search(context.Background(), "x.zip", bytes.NewReader(unsupportedZip(t)), regexp.MustCompile("x"), nil,
+        func(SearchMatch) error { return nil })
+    var me *MethodError
+    if !errors.As(err, &me) {
+        t.Errorf("got %v, want a MethodError", err)
+    }
+}
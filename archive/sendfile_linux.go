@@ -0,0 +1,50 @@
+package archive
+
+import (
+    "errors"
+    "os"
+    "syscall"
+)
+
+var errNoSendFile = errors.New("archive: sendfile not supported")
+
+// sendFile copies n bytes at offset off of src to dst with sendfile(2),
+// which has accepted any file as the destination since Linux 2.6.33.
+func sendFile(dst, src *os.File, off, n int64) (int64, error) {
+    dc, err := dst.SyscallConn()
+    if err != nil {
+        return 0, errNoSendFile
+    }
+    sc, err := src.SyscallConn()
+    if err != nil {
+        return 0, errNoSendFile
+    }
+    var written int
+    var serr error
+    err = dc.Control(func(dfd uintptr) {
+        err := sc.Control(func(sfd uintptr) {
+            for {
+                written, serr = syscall.Sendfile(int(dfd), int(sfd), &off, int(n))
+                if serr != syscall.EINTR && serr != syscall.EAGAIN {
+                    return
+                }
+            }
+        })
+        if err != nil {
+            serr = err
+        }
+    })
+    if err != nil {
+        return 0, err
+    }
+    switch serr {
+    case nil:
+        return int64(written), nil
+    case syscall.EINVAL, syscall.ENOSYS, syscall.EOPNOTSUPP, syscall.EXDEV:
+        return 0, errNoSendFile
+    }
+    if written < 0 {
+        written = 0
+    }
+    return int64(written), &os.SyscallError{Syscall: "sendfile", Err: serr}
+}
@@ -0,0 +1,16 @@
+//go:build !linux
+// +build !linux
+
+package archive
+
+import (
+    "errors"
+    "os"
+)
+
+var errNoSendFile = errors.New("archive: sendfile not supported")
+
+// sendFile always reports that files cannot be copied inside the kernel.
+func sendFile(dst, src *os.File, off, n int64) (int64, error) {
+    return 0, errNoSendFile
+}
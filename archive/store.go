@@ -0,0 +1,506 @@
+package archive
+
+import (
+    "archive/tar"
+    "context"
+    "crypto/sha256"
+    "encoding/hex"
+    "encoding/json"
+    "errors"
+    "fmt"
+    "io"
+    "io/ioutil"
+    "math/bits"
+    "os"
+    "path/filepath"
+    "sort"
+    "strings"
+    "time"
+)
+
+// Store keeps snapshots of file trees in a local directory, storing the
+// content of their files once however many snapshots share it. Contents
+// are cut into chunks where a rolling hash of the last bytes hits a
+// pattern, so an insertion only changes the chunks around it, and each
+// distinct chunk is kept under its SHA-256. A snapshot is a JSON index of
+// entries pointing at their chunks:
+//
+//	config.json            the chunking parameters, fixed for the store
+//	chunks/ab/abcdef...    chunks by SHA-256
+//	snapshots/name.json    snapshot indexes
+//
+// A Store is not safe for concurrent use, and Prune must not run while
+// another process adds a snapshot to the same directory.
+type Store struct {
+    dir string
+    cfg storeConfig
+}
+
+// StoreOptions configures the chunking of a new store. They only apply
+// when the store is created; an existing store keeps its own, since
+// changing them would cut the same content differently.
+type StoreOptions struct {
+    // MinChunkSize, AvgChunkSize and MaxChunkSize bound the chunks.
+    // AvgChunkSize is rounded down to a power of two. Zeros mean 256 KiB,
+    // 1 MiB and 4 MiB.
+    MinChunkSize, AvgChunkSize, MaxChunkSize int
+}
+
+type storeConfig struct {
+    Version      int `json:"version"`
+    MinChunkSize int `json:"min_chunk_size"`
+    AvgChunkSize int `json:"avg_chunk_size"`
+    MaxChunkSize int `json:"max_chunk_size"`
+}
+
+// Snapshot is the index of a stored file tree.
+type Snapshot struct {
+    Name    string          `json:"name"`
+    Created time.Time       `json:"created"`
+    Entries []SnapshotEntry `json:"entries"`
+}
+
+// SnapshotEntry is an entry of a snapshot: its metadata, as in a
+// manifest, and for files the SHA-256 of each chunk of its content.
+type SnapshotEntry struct {
+    ManifestEntry
+    Chunks []string `json:"chunks,omitempty"`
+}
+
+// Size returns the total size of the files of s.
+func (s *Snapshot) Size() int64 {
+    var n int64
+    for _, e := range s.Entries {
+        if e.Size != nil {
+            n += *e.Size
+        }
+    }
+    return n
+}
+
+var (
+    // ErrSnapshotExists is returned when creating a snapshot under a name
+    // already taken.
+    ErrSnapshotExists = errors.New("archive: snapshot exists")
+    // ErrNoSnapshot is returned for snapshots that do not exist.
+    ErrNoSnapshot = errors.New("archive: no such snapshot")
+)
+
+// OpenStore opens the store in dir, creating it with opts if dir holds no
+// store yet.
+func OpenStore(dir string, opts *StoreOptions) (*Store, error) {
+    s := &Store{dir: dir}
+    b, err := ioutil.ReadFile(filepath.Join(dir, "config.json"))
+    if err == nil {
+        if err := json.Unmarshal(b, &s.cfg); err != nil {
+            return nil, fmt.Errorf("archive: store config: %w", err)
+        }
+        if s.cfg.Version != 1 {
+            return nil, fmt.Errorf("archive: store version %d not supported", s.cfg.Version)
+        }
+        return s, s.cfg.check()
+    }
+    if !os.IsNotExist(err) {
+        return nil, err
+    }
+    if opts == nil {
+        opts = &StoreOptions{}
+    }
+    s.cfg = storeConfig{Version: 1, MinChunkSize: 256 << 10, AvgChunkSize: 1 << 20, MaxChunkSize: 4 << 20}
+    if opts.MinChunkSize != 0 {
+        s.cfg.MinChunkSize = opts.MinChunkSize
+    }
+    if opts.AvgChunkSize != 0 {
+        s.cfg.AvgChunkSize = opts.AvgChunkSize
+    }
+    if opts.MaxChunkSize != 0 {
+        s.cfg.MaxChunkSize = opts.MaxChunkSize
+    }
+    for s.cfg.AvgChunkSize&(s.cfg.AvgChunkSize-1) != 0 {
+        s.cfg.AvgChunkSize &= s.cfg.AvgChunkSize - 1
+    }
+    if err := s.cfg.check(); err != nil {
+        return nil, err
+    }
+    for _, sub := range []string{"chunks", "snapshots"} {
+        if err := os.MkdirAll(filepath.Join(dir, sub), 0755); err != nil {
+            return nil, err
+        }
+    }
+    b, err = json.MarshalIndent(&s.cfg, "", "  ")
+    if err != nil {
+        return nil, err
+    }
+    if err := writeFileAtomic(filepath.Join(dir, "config.json"), append(b, '\n')); err != nil {
+        return nil, err
+    }
+    return s, nil
+}
+
+func (c *storeConfig) check() error {
+    if c.MinChunkSize < 64 || c.AvgChunkSize <= c.MinChunkSize || c.MaxChunkSize < c.AvgChunkSize {
+        return fmt.Errorf("archive: chunk sizes %d, %d and %d out of order", c.MinChunkSize, c.AvgChunkSize, c.MaxChunkSize)
+    }
+    return nil
+}
+
+// Create stores the entries read from er as the snapshot name.
+func (s *Store) Create(ctx context.Context, name string, er EntryReader) (*Snapshot, error) {
+    path, err := s.snapshotPath(name)
+    if err != nil {
+        return nil, err
+    }
+    if _, err := os.Stat(path); err == nil {
+        return nil, fmt.Errorf("%w: %s", ErrSnapshotExists, name)
+    }
+    snap := &Snapshot{Name: name, Created: time.Now().UTC()}
+    for {
+        if err := ctx.Err(); err != nil {
+            return nil, err
+        }
+        hdr, err := er.Next()
+        if err == io.EOF {
+            break
+        }
+        if err != nil {
+            return nil, err
+        }
+        if hdr.Typeflag == tar.TypeXGlobalHeader {
+            continue
+        }
+        c := s.newChunker()
+        me, err := manifestEntry(hdr, true, func() (io.ReadCloser, error) {
+            return ioutil.NopCloser(io.TeeReader(&ctxReader{ctx: ctx, r: er}, c)), nil
+        })
+        if err == nil {
+            err = c.close()
+        }
+        if err != nil {
+            return nil, &EntryError{Name: hdr.Name, Err: err}
+        }
+        e := SnapshotEntry{ManifestEntry: me}
+        if me.Size != nil {
+            e.Chunks = c.ids
+        }
+        snap.Entries = append(snap.Entries, e)
+    }
+    b, err := json.MarshalIndent(snap, "", "  ")
+    if err != nil {
+        return nil, err
+    }
+    if err := writeFileAtomic(path, append(b, '\n')); err != nil {
+        return nil, err
+    }
+    return snap, nil
+}
+
+// CreateFromDir stores the directory tree at src as the snapshot name,
+// with the metadata WriteTar would record.
+func (s *Store) CreateFromDir(ctx context.Context, name, src string) (*Snapshot, error) {
+    pr, pw := io.Pipe()
+    go func() {
+        pw.CloseWithError(WriteTar(ctx, pw, src, nil))
+    }()
+    snap, err := s.Create(ctx, name, tar.NewReader(pr))
+    pr.CloseWithError(errors.New("archive: snapshot abandoned"))
+    return snap, err
+}
+
+// CreateFromFile stores the entries of the archive file src, in any
+// format LoadModel or the sequential readers know, as the snapshot name.
+func (s *Store) CreateFromFile(ctx context.Context, name, src string) (*Snapshot, error) {
+    m, f, err := loadModelFile(src)
+    if f != nil {
+        defer f.Close()
+    }
+    if err != nil {
+        return nil, err
+    }
+    pr, pw := io.Pipe()
+    go func() {
+        pw.CloseWithError(m.Save(pw, FormatTar))
+    }()
+    snap, err := s.Create(ctx, name, tar.NewReader(pr))
+    pr.CloseWithError(errors.New("archive: snapshot abandoned"))
+    return snap, err
+}
+
+// Snapshot loads the index of the snapshot name.
+func (s *Store) Snapshot(name string) (*Snapshot, error) {
+    path, err := s.snapshotPath(name)
+    if err != nil {
+        return nil, err
+    }
+    b, err := ioutil.ReadFile(path)
+    if os.IsNotExist(err) {
+        return nil, fmt.Errorf("%w: %s", ErrNoSnapshot, name)
+    }
+    if err != nil {
+        return nil, err
+    }
+    snap := &Snapshot{}
+    if err := json.Unmarshal(b, snap); err != nil {
+        return nil, fmt.Errorf("archive: snapshot %s: %w", name, err)
+    }
+    return snap, nil
+}
+
+// Snapshots loads every snapshot, oldest first.
+func (s *Store) Snapshots() ([]*Snapshot, error) {
+    infos, err := ioutil.ReadDir(filepath.Join(s.dir, "snapshots"))
+    if err != nil {
+        return nil, err
+    }
+    var snaps []*Snapshot
+    for _, fi := range infos {
+        name := strings.TrimSuffix(fi.Name(), ".json")
+        if name == fi.Name() || strings.HasPrefix(name, ".") {
+            continue
+        }
+        snap, err := s.Snapshot(name)
+        if err != nil {
+            return nil, err
+        }
+        snaps = append(snaps, snap)
+    }
+    sort.SliceStable(snaps, func(i, j int) bool { return snaps[i].Created.Before(snaps[j].Created) })
+    return snaps, nil
+}
+
+// Open returns the entries of the snapshot name. The content of each
+// chunk is checked against its hash as it is read.
+func (s *Store) Open(name string) (EntryReader, error) {
+    snap, err := s.Snapshot(name)
+    if err != nil {
+        return nil, err
+    }
+    return &snapshotReader{s: s, entries: snap.Entries, i: -1}, nil
+}
+
+// Restore extracts the snapshot name into the directory dst.
+func (s *Store) Restore(ctx context.Context, name, dst string, opts *ExtractOptions) error {
+    er, err := s.Open(name)
+    if err != nil {
+        return err
+    }
+    return extractEntries(ctx, nil, func(io.Reader) EntryReader { return er }, dst, opts)
+}
+
+// Remove deletes the snapshot name. Its chunks stay until Prune.
+func (s *Store) Remove(name string) error {
+    path, err := s.snapshotPath(name)
+    if err != nil {
+        return err
+    }
+    err = os.Remove(path)
+    if os.IsNotExist(err) {
+        return fmt.Errorf("%w: %s", ErrNoSnapshot, name)
+    }
+    return err
+}
+
+// PruneStats reports what Prune deleted.
+type PruneStats struct {
+    Chunks int
+    Bytes  int64
+}
+
+// Prune deletes the chunks no snapshot refers to.
+func (s *Store) Prune() (PruneStats, error) {
+    var stats PruneStats
+    snaps, err := s.Snapshots()
+    if err != nil {
+        return stats, err
+    }
+    used := map[string]bool{}
+    for _, snap := range snaps {
+        for _, e := range snap.Entries {
+            for _, id := range e.Chunks {
+                used[id] = true
+            }
+        }
+    }
+    err = filepath.Walk(filepath.Join(s.dir, "chunks"), func(path string, fi os.FileInfo, err error) error {
+        if err != nil || fi.IsDir() || used[fi.Name()] {
+            return err
+        }
+        if err := os.Remove(path); err != nil {
+            return err
+        }
+        stats.Chunks++
+        stats.Bytes += fi.Size()
+        return nil
+    })
+    return stats, err
+}
+
+// snapshotPath returns the index file of the snapshot name, which must be
+// usable as a file name.
+func (s *Store) snapshotPath(name string) (string, error) {
+    if name == "" || strings.HasPrefix(name, ".") || strings.ContainsAny(name, `/\:`) {
+        return "", fmt.Errorf("archive: bad snapshot name %q", name)
+    }
+    return filepath.Join(s.dir, "snapshots", name+".json"), nil
+}
+
+func (s *Store) chunkPath(id string) string {
+    return filepath.Join(s.dir, "chunks", id[:2], id)
+}
+
+// putChunk stores a chunk unless the store already has it.
+func (s *Store) putChunk(data []byte) (string, error) {
+    sum := sha256.Sum256(data)
+    id := hex.EncodeToString(sum[:])
+    path := s.chunkPath(id)
+    if _, err := os.Stat(path); err == nil {
+        return id, nil
+    }
+    if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+        return "", err
+    }
+    return id, writeFileAtomic(path, data)
+}
+
+// getChunk reads a chunk and checks it against its id.
+func (s *Store) getChunk(id string) ([]byte, error) {
+    if len(id) != 2*sha256.Size {
+        return nil, fmt.Errorf("archive: bad chunk id %q", id)
+    }
+    data, err := ioutil.ReadFile(s.chunkPath(id))
+    if err != nil {
+        return nil, err
+    }
+    if sum := sha256.Sum256(data); hex.EncodeToString(sum[:]) != id {
+        return nil, fmt.Errorf("archive: chunk %s is corrupt", id)
+    }
+    return data, nil
+}
+
+// writeFileAtomic writes a file through a temporary file renamed into
+// place, so that readers never see it half written.
+func writeFileAtomic(name string, data []byte) error {
+    tmp, err := ioutil.TempFile(filepath.Dir(name), "."+filepath.Base(name)+".")
+    if err != nil {
+        return err
+    }
+    _, err = tmp.Write(data)
+    if cerr := tmp.Close(); err == nil {
+        err = cerr
+    }
+    if err == nil {
+        err = os.Rename(tmp.Name(), name)
+    }
+    if err != nil {
+        os.Remove(tmp.Name())
+    }
+    return err
+}
+
+// snapshotReader reads the entries of a snapshot.
+type snapshotReader struct {
+    s       *Store
+    entries []SnapshotEntry
+    i       int
+    chunks  []string // chunks of the current entry not read yet
+    buf     []byte   // unread part of the current chunk
+}
+
+func (r *snapshotReader) Next() (*tar.Header, error) {
+    r.i++
+    if r.i >= len(r.entries) {
+        return nil, io.EOF
+    }
+    e := &r.entries[r.i]
+    hdr := &tar.Header{Typeflag: tar.TypeReg}
+    if e.Size != nil {
+        hdr.Size = *e.Size
+    }
+    if err := applyManifest(hdr, &e.ManifestEntry, false); err != nil {
+        return nil, &EntryError{Name: e.Name, Err: err}
+    }
+    r.chunks, r.buf = e.Chunks, nil
+    return hdr, nil
+}
+
+func (r *snapshotReader) Read(p []byte) (int, error) {
+    for len(r.buf) == 0 {
+        if len(r.chunks) == 0 {
+            return 0, io.EOF
+        }
+        data, err := r.s.getChunk(r.chunks[0])
+        if err != nil {
+            return 0, err
+        }
+        r.chunks, r.buf = r.chunks[1:], data
+    }
+    n := copy(p, r.buf)
+    r.buf = r.buf[n:]
+    return n, nil
+}
+
+// chunker cuts the content written to it into chunks with a gear hash,
+// the rolling hash of FastCDC: each byte shifts the hash left and adds a
+// random value for the byte, so the hash depends on the last 64 bytes
+// only. A chunk ends where the high log2(AvgChunkSize) bits are zero; the
+// low bits would depend on as few bytes as they number.
+type chunker struct {
+    s    *Store
+    mask uint64
+    hash uint64
+    buf  []byte
+    ids  []string
+}
+
+func (s *Store) newChunker() *chunker {
+    avg := uint64(s.cfg.AvgChunkSize - 1)
+    return &chunker{s: s, mask: avg << (64 - bits.Len64(avg))}
+}
+
+func (c *chunker) Write(p []byte) (int, error) {
+    min, max := c.s.cfg.MinChunkSize, c.s.cfg.MaxChunkSize
+    for i, b := range p {
+        c.buf = append(c.buf, b)
+        if len(c.buf) < min {
+            continue
+        }
+        c.hash = c.hash<<1 + gearTable[b]
+        if c.hash&c.mask == 0 || len(c.buf) >= max {
+            if err := c.cut(); err != nil {
+                return i, err
+            }
+        }
+    }
+    return len(p), nil
+}
+
+func (c *chunker) cut() error {
+    id, err := c.s.putChunk(c.buf)
+    if err != nil {
+        return err
+    }
+    c.ids = append(c.ids, id)
+    c.buf, c.hash = c.buf[:0], 0
+    return nil
+}
+
+// close stores the last, short chunk.
+func (c *chunker) close() error {
+    if len(c.buf) == 0 {
+        return nil
+    }
+    return c.cut()
+}
+
+// gearTable holds the random values of the gear hash. They are derived
+// from a fixed seed with splitmix64, since every store must cut alike.
+var gearTable = func() (t [256]uint64) {
+    x := uint64(0x6172636869766521)
+    for i := range t {
+        x += 0x9e3779b97f4a7c15
+        z := x
+        z = (z ^ z>>30) * 0xbf58476d1ce4e5b9
+        z = (z ^ z>>27) * 0x94d049bb133111eb
+        t[i] = z ^ z>>31
+    }
+    return t
+}()
@@ -0,0 +1,130 @@
+package archive
+
+import (
+    "context"
+    "errors"
+    "io/ioutil"
+    "math/rand"
+    "os"
+    "path/filepath"
+    "strings"
+    "testing"
+)
+
+func storeChunks(t *testing.T, dir string) int {
+    n := 0
+    err := filepath.Walk(filepath.Join(dir, "chunks"), func(p string, fi os.FileInfo, err error) error {
+        if err == nil && !fi.IsDir() {
+            n++
+        }
+        return err
+    })
+    if err != nil {
+        t.Fatal(err)
+    }
+    return n
+}
+
+func TestStore(t *testing.T) {
+    ctx := context.Background()
+    dir := tempDir(t)
+    s, err := OpenStore(dir, &StoreOptions{MinChunkSize: 1 << 10, AvgChunkSize: 4 << 10, MaxChunkSize: 16 << 10})
+    if err != nil {
+        t.Fatal(err)
+    }
+
+    rnd := rand.New(rand.NewSource(1))
+    big := make([]byte, 256<<10)
+    rnd.Read(big)
+    v1 := map[string]string{"big.bin": string(big), "docs/readme.txt": "readme", "empty": ""}
+    src := tempDir(t)
+    writeTree(t, src, v1)
+    if _, err := s.CreateFromDir(ctx, "v1", src); err != nil {
+        t.Fatal(err)
+    }
+    first := storeChunks(t, dir)
+
+    // Inserting bytes near the start only changes the chunks around the
+    // insertion.
+    v2 := map[string]string{"big.bin": string(big[:1000]) + "inserted" + string(big[1000:]), "docs/readme.txt": "readme"}
+    os.Remove(filepath.Join(src, "empty"))
+    writeTree(t, src, v2)
+    if _, err := s.CreateFromDir(ctx, "v2", src); err != nil {
+        t.Fatal(err)
+    }
+    if added := storeChunks(t, dir) - first; added == 0 || added > 4 {
+        t.Errorf("second snapshot added %d chunks to %d", added, first)
+    }
+    if _, err := s.CreateFromDir(ctx, "v2", src); !errors.Is(err, ErrSnapshotExists) {
+        t.Errorf("duplicate snapshot: %v", err)
+    }
+
+    // The configuration persists.
+    s, err = OpenStore(dir, nil)
+    if err != nil {
+        t.Fatal(err)
+    }
+    snaps, err := s.Snapshots()
+    if err != nil || len(snaps) != 2 || snaps[0].Name != "v1" || snaps[1].Name != "v2" {
+        t.Fatalf("Snapshots = %v, %v", snaps, err)
+    }
+    if got := snaps[0].Size(); got != int64(len(big)+len("readme")) {
+        t.Errorf("v1 size = %d", got)
+    }
+
+    for name, files := range map[string]map[string]string{"v1": v1, "v2": v2} {
+        dst := tempDir(t)
+        if err := s.Restore(ctx, name, dst, nil); err != nil {
+            t.Fatal(err)
+        }
+        checkTree(t, dst, files)
+    }
+
+    if err := s.Remove("v1"); err != nil {
+        t.Fatal(err)
+    }
+    stats, err := s.Prune()
+    if err != nil {
+        t.Fatal(err)
+    }
+    if stats.Chunks == 0 || stats.Chunks > 4 {
+        t.Errorf("pruned %d chunks", stats.Chunks)
+    }
+    dst := tempDir(t)
+    if err := s.Restore(ctx, "v2", dst, nil); err != nil {
+        t.Fatal(err)
+    }
+    checkTree(t, dst, v2)
+    if err := s.Restore(ctx, "v1", dst, nil); !errors.Is(err, ErrNoSnapshot) {
+        t.Errorf("restoring a removed snapshot: %v", err)
+    }
+}
+
+func TestStoreCorruptChunk(t *testing.T) {
+    ctx := context.Background()
+    dir := tempDir(t)
+    s, err := OpenStore(dir, nil)
+    if err != nil {
+        t.Fatal(err)
+    }
+    src := tempDir(t)
+    writeTree(t, src, treeFiles)
+    if _, err := s.CreateFromDir(ctx, "snap", src); err != nil {
+        t.Fatal(err)
+    }
+    snap, err := s.Snapshot("snap")
+    if err != nil {
+        t.Fatal(err)
+    }
+    for _, e := range snap.Entries {
+        if e.Name == "gopher.txt" {
+            if err := ioutil.WriteFile(s.chunkPath(e.Chunks[0]), []byte("Gopher names:\nGus"), 0644); err != nil {
+                t.Fatal(err)
+            }
+        }
+    }
+    err = s.Restore(ctx, "snap", tempDir(t), nil)
+    if err == nil || !strings.Contains(err.Error(), "corrupt") {
+        t.Errorf("restoring a corrupt chunk: %v", err)
+    }
+}
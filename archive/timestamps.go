@@ -0,0 +1,135 @@
+package archive
+
+import (
+    "archive/tar"
+    "archive/zip"
+    "encoding/binary"
+    "time"
+)
+
+// Zip archives record modification times in up to three places. The DOS
+// date and time in every header has two-second resolution and no time
+// zone; like Info-ZIP, this package writes it in local time and reads it
+// as local time. The Info-ZIP extended timestamp field (0x5455) holds Unix
+// seconds, and the NTFS field (0x000a) holds Windows FILETIMEs with 100ns
+// resolution. Readers prefer the NTFS field, then the extended timestamp.
+
+// fileTimeEpoch is the Unix time of the FILETIME epoch, 1601-01-01 UTC,
+// in 100ns units.
+const fileTimeEpoch = 116444736000000000
+
+// zipModified returns the modification time recorded in fh. Headers not
+// read from an archive, with no DOS date and time, return fh.Modified.
+func zipModified(fh *zip.FileHeader) time.Time {
+    var ntfs, ext time.Time
+    forEachExtra(fh.Extra, func(id uint16, field []byte) {
+        switch id {
+        case ntfsExtraID:
+            if t, ok := ntfsModified(field); ok {
+                ntfs = t
+            }
+        case extTimeExtraID:
+            if len(field) >= 5 && field[0]&1 != 0 {
+                ext = time.Unix(int64(int32(binary.LittleEndian.Uint32(field[1:]))), 0).UTC()
+            }
+        }
+    })
+    switch {
+    case !ntfs.IsZero():
+        return ntfs
+    case !ext.IsZero():
+        return ext
+    case fh.ModifiedDate == 0 && fh.ModifiedTime == 0:
+        return fh.Modified
+    }
+    return dosTime(fh.ModifiedDate, fh.ModifiedTime)
+}
+
+// ntfsModified returns the modification time in the body of an NTFS
+// extra field, which holds a reserved word followed by tagged attributes.
+func ntfsModified(field []byte) (time.Time, bool) {
+    if len(field) < 4 {
+        return time.Time{}, false
+    }
+    b := readBuf(field[4:])
+    for len(b) >= 4 {
+        tag, size := b.uint16(), int(b.uint16())
+        if size > len(b) {
+            break
+        }
+        if tag == 1 && size >= 24 {
+            ft := int64(b.uint64())
+            if ft == 0 {
+                break
+            }
+            ft -= fileTimeEpoch
+            return time.Unix(ft/1e7, ft%1e7*100).UTC(), true
+        }
+        b = b[size:]
+    }
+    return time.Time{}, false
+}
+
+// ntfsExtra returns an NTFS extra field giving t as the modification,
+// access and creation time.
+func ntfsExtra(t time.Time) []byte {
+    ft := uint64(t.Unix()*1e7 + int64(t.Nanosecond()/100) + fileTimeEpoch)
+    b := make([]byte, 36)
+    binary.LittleEndian.PutUint16(b, ntfsExtraID)
+    binary.LittleEndian.PutUint16(b[2:], 32)
+    binary.LittleEndian.PutUint16(b[8:], 1)
+    binary.LittleEndian.PutUint16(b[10:], 24)
+    for i := 0; i < 3; i++ {
+        binary.LittleEndian.PutUint64(b[12+8*i:], ft)
+    }
+    return b
+}
+
+// dosTime converts an MS-DOS date and time to a time.Time in local time.
+func dosTime(d, t uint16) time.Time {
+    return time.Date(
+        int(d>>9+1980), time.Month(d>>5&0xf), int(d&0x1f),
+        int(t>>11), int(t>>5&0x3f), int(t&0x1f*2),
+        0, time.Local)
+}
+
+// setZipModified records t in a header for zip.Writer.CreateHeader,
+// which writes the DOS date and time, in the location of Modified, and
+// an extended timestamp field. The NTFS field added here keeps the time
+// to 100ns.
+func setZipModified(fh *zip.FileHeader, t time.Time) {
+    if t.IsZero() {
+        return
+    }
+    fh.Modified = t.Local()
+    fh.Extra = append(stripExtra(fh.Extra, extTimeExtraID, ntfsExtraID), ntfsExtra(t)...)
+}
+
+// setRawModified records t in a header for zip.Writer.CreateRaw, which,
+// unlike CreateHeader, writes the DOS date and time and the extra fields
+// as given rather than deriving them from Modified.
+func setRawModified(fh *zip.FileHeader, t time.Time) {
+    fh.Modified = t
+    fh.Extra = stripExtra(fh.Extra, extTimeExtraID, ntfsExtraID)
+    if t.IsZero() {
+        return
+    }
+    l := t.Local()
+    fh.ModifiedDate = uint16(l.Day() + int(l.Month())<<5 + (l.Year()-1980)<<9)
+    fh.ModifiedTime = uint16(l.Second()/2 + l.Minute()<<5 + l.Hour()<<11)
+    var b [9]byte
+    binary.LittleEndian.PutUint16(b[:], extTimeExtraID)
+    binary.LittleEndian.PutUint16(b[2:], 5)
+    b[4] = 1 // modification time only
+    binary.LittleEndian.PutUint32(b[5:], uint32(t.Unix()))
+    fh.Extra = append(fh.Extra, b[:]...)
+    fh.Extra = append(fh.Extra, ntfsExtra(t)...)
+}
+
+// keepTarNanos prepares hdr for tar.Writer, which rounds times to whole
+// seconds unless the header is written in PAX format.
+func keepTarNanos(hdr *tar.Header) {
+    if hdr.Format == tar.FormatUnknown && hdr.ModTime.Nanosecond() != 0 {
+        hdr.Format = tar.FormatPAX
+    }
+}
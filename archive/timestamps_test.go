@@ -0,0 +1,152 @@
+package archive
+
+import (
+    "archive/tar"
+    "archive/zip"
+    "bytes"
+    "context"
+    "io"
+    "os"
+    "path/filepath"
+    "testing"
+    "time"
+)
+
+// convert loads the archive in b and saves it in format.
+func convert(t *testing.T, b []byte, format Format) []byte {
+    m, err := LoadModel(bytes.NewReader(b), int64(len(b)))
+    if err != nil {
+        t.Fatal(err)
+    }
+    var buf bytes.Buffer
+    if err := m.Save(&buf, format); err != nil {
+        t.Fatal(err)
+    }
+    return buf.Bytes()
+}
+
+func tarTimes(t *testing.T, b []byte) map[string]time.Time {
+    times := map[string]time.Time{}
+    tr := tar.NewReader(bytes.NewReader(b))
+    for {
+        hdr, err := tr.Next()
+        if err == io.EOF {
+            return times
+        }
+        if err != nil {
+            t.Fatal(err)
+        }
+        times[hdr.Name] = hdr.ModTime
+    }
+}
+
+func TestTimestampConversion(t *testing.T) {
+    mtimes := map[string]time.Time{
+        "dir/":         time.Date(2019, 12, 31, 23, 59, 59, 999999900, time.UTC),
+        "dir/file.txt": time.Date(2021, 3, 4, 5, 6, 7, 123456700, time.UTC),
+        "old.txt":      time.Date(1975, 1, 2, 3, 4, 5, 600, time.UTC),
+    }
+    var buf bytes.Buffer
+    tw := tar.NewWriter(&buf)
+    for _, name := range []string{"dir/", "dir/file.txt", "old.txt"} {
+        hdr := &tar.Header{Name: name, Mode: 0644, ModTime: mtimes[name], Typeflag: tar.TypeReg, Format: tar.FormatPAX}
+        if name == "dir/" {
+            hdr.Typeflag = tar.TypeDir
+        }
+        if err := tw.WriteHeader(hdr); err != nil {
+            t.Fatal(err)
+        }
+    }
+    tw.Close()
+
+    zipped := convert(t, buf.Bytes(), FormatZip)
+    zr, err := zip.NewReader(bytes.NewReader(zipped), int64(len(zipped)))
+    if err != nil {
+        t.Fatal(err)
+    }
+    for _, f := range zr.File {
+        var ids []uint16
+        forEachExtra(f.Extra, func(id uint16, field []byte) { ids = append(ids, id) })
+        if len(ids) != 2 {
+            t.Errorf("%s: extra fields %x", f.Name, ids)
+        }
+        if got := zipHeader(&f.FileHeader).ModTime; !got.Equal(mtimes[f.Name]) {
+            t.Errorf("zip %s: mtime %v, want %v", f.Name, got, mtimes[f.Name])
+        }
+    }
+
+    // Unchanged zip entries are copied raw, with their times rewritten.
+    for _, b := range [][]byte{convert(t, zipped, FormatTar), convert(t, convert(t, zipped, FormatZip), FormatTar)} {
+        got := tarTimes(t, b)
+        for name, want := range mtimes {
+            if !got[name].Equal(want) {
+                t.Errorf("tar %s: mtime %v, want %v", name, got[name], want)
+            }
+        }
+    }
+}
+
+func TestExtractNanoseconds(t *testing.T) {
+    src := tempDir(t)
+    writeTree(t, src, map[string]string{"a.txt": "a", "sub/b.txt": "b"})
+    mtime := time.Date(2022, 8, 9, 10, 11, 12, 345678900, time.UTC)
+    for _, name := range []string{"a.txt", "sub/b.txt", "sub"} {
+        if err := os.Chtimes(filepath.Join(src, name), mtime, mtime); err != nil {
+            t.Fatal(err)
+        }
+    }
+    if fi, _ := os.Stat(filepath.Join(src, "a.txt")); !fi.ModTime().Equal(mtime) {
+        t.Skip("file system does not keep sub-second times")
+    }
+    for _, ext := range []string{".zip", ".tar"} {
+        arc := filepath.Join(tempDir(t), "tree"+ext)
+        if err := Create(context.Background(), arc, src, nil); err != nil {
+            t.Fatal(err)
+        }
+        dst := tempDir(t)
+        if err := Extract(context.Background(), arc, dst, nil); err != nil {
+            t.Fatal(err)
+        }
+        for _, name := range []string{"a.txt", "sub/b.txt", "sub"} {
+            fi, err := os.Stat(filepath.Join(dst, name))
+            if err != nil {
+                t.Fatal(err)
+            }
+            if !fi.ModTime().Equal(mtime) {
+                t.Errorf("%s %s: mtime %v, want %v", ext, name, fi.ModTime(), mtime)
+            }
+        }
+    }
+}
+
+func TestDOSTimeIsLocal(t *testing.T) {
+    defer func(loc *time.Location) { time.Local = loc }(time.Local)
+    time.Local = time.FixedZone("UTC+8", 8*60*60)
+    mtime := time.Date(2020, 1, 2, 3, 4, 6, 0, time.UTC)
+
+    var buf bytes.Buffer
+    zw := zip.NewWriter(&buf)
+    fh := &zip.FileHeader{Name: "extras"}
+    setZipModified(fh, mtime)
+    if _, err := zw.CreateHeader(fh); err != nil {
+        t.Fatal(err)
+    }
+    // A header with only the DOS date and time, 11:04:06 on 2 January.
+    dos := &zip.FileHeader{Name: "dos", ModifiedDate: 40<<9 | 1<<5 | 2, ModifiedTime: 11<<11 | 4<<5 | 3}
+    if _, err := zw.CreateRaw(dos); err != nil {
+        t.Fatal(err)
+    }
+    zw.Close()
+    zr, err := zip.NewReader(bytes.NewReader(buf.Bytes()), int64(buf.Len()))
+    if err != nil {
+        t.Fatal(err)
+    }
+    for _, f := range zr.File {
+        if f.ModifiedTime>>11 != 11 {
+            t.Errorf("%s: DOS hour %d, want local hour 11", f.Name, f.ModifiedTime>>11)
+        }
+        if got := zipHeader(&f.FileHeader).ModTime; !got.Equal(mtime) {
+            t.Errorf("%s: mtime %v, want %v", f.Name, got, mtime)
+        }
+    }
+}
@@ -0,0 +1,186 @@
+package archive
+
+import (
+    "archive/zip"
+    "compress/flate"
+    "context"
+    "encoding/binary"
+    "fmt"
+    "hash/crc32"
+    "io"
+    "os"
+)
+
+// zipAlignExtraID is the extra field Android's zipalign pads local headers
+// with: the alignment as two bytes, then zeros.
+const zipAlignExtraID = 0xd935
+
+// zipAligner writes the files of an archive so that the content of stored
+// entries starts at a multiple of align bytes from the start of the
+// archive, padding their local headers. That needs the position of every
+// local header before it is written, which a zip.Writer only has once the
+// previous entry is closed: the tail of a deflate stream and a data
+// descriptor are written then. So files are written raw: stored ones with
+// their sizes and CRC worked out first and no data descriptor, deflated
+// ones compressed as they are read, followed by a data descriptor whose
+// length is known in advance.
+//
+// zip.Writer keeps the header given to CreateRaw and writes the data
+// descriptor and the central directory from it, so the sizes of a deflated
+// entry are set on its header once it is written, and the padding is taken
+// off the header once the local header is out.
+type zipAligner struct {
+    zw    *zip.Writer
+    off   int64 // bytes the zip writer has flushed
+    align int
+    level int
+    last  *zip.FileHeader // deflated entry whose data descriptor is pending
+}
+
+// writeFile writes the file name as the entry fh. Stored files are read
+// twice, to checksum them first.
+func (a *zipAligner) writeFile(ctx context.Context, fh *zip.FileHeader, name string, tk *tracker) error {
+    f, err := os.Open(name)
+    if err != nil {
+        return err
+    }
+    defer f.Close()
+    if fh.Method != zip.Store {
+        return a.writeDeflated(ctx, fh, f, tk)
+    }
+    crc := crc32.NewIEEE()
+    size, err := copyBuffer(crc, &ctxReader{ctx: ctx, r: f})
+    if err == nil {
+        _, err = f.Seek(0, io.SeekStart)
+    }
+    if err != nil {
+        return err
+    }
+    fh.CRC32 = crc.Sum32()
+    fh.UncompressedSize64 = uint64(size)
+    fh.CompressedSize64 = uint64(size)
+    w, err := a.create(fh)
+    if err != nil {
+        return err
+    }
+    n, err := copyBuffer(w, &ctxReader{ctx: ctx, r: io.LimitReader(f, size+1), count: tk.addIn})
+    if err == nil && n != size {
+        err = fmt.Errorf("archive: %s changed size while being archived", name)
+    }
+    return err
+}
+
+// writeDeflated compresses r into the entry fh as it is read.
+func (a *zipAligner) writeDeflated(ctx context.Context, fh *zip.FileHeader, r io.Reader, tk *tracker) error {
+    fh.CRC32, fh.CompressedSize64, fh.UncompressedSize64 = 0, 0, 0
+    w, err := a.create(fh)
+    if err != nil {
+        return err
+    }
+    var compressed int64
+    fw, err := flate.NewWriter(&ctxWriter{ctx: ctx, w: w, count: func(n int64) { compressed += n }}, a.level)
+    if err != nil {
+        return err
+    }
+    crc := crc32.NewIEEE()
+    size, err := copyBuffer(io.MultiWriter(fw, crc), &ctxReader{ctx: ctx, r: r, count: tk.addIn})
+    if err == nil {
+        err = fw.Close()
+    }
+    if err != nil {
+        return err
+    }
+    fh.CRC32 = crc.Sum32()
+    fh.CompressedSize64, fh.UncompressedSize64 = uint64(compressed), uint64(size)
+    fh.CompressedSize, fh.UncompressedSize = clamp32(fh.CompressedSize64), clamp32(fh.UncompressedSize64)
+    return nil
+}
+
+// writeBytes writes b as the stored entry fh.
+func (a *zipAligner) writeBytes(fh *zip.FileHeader, b []byte) error {
+    fh.Method = zip.Store
+    fh.CRC32 = crc32.ChecksumIEEE(b)
+    fh.UncompressedSize64 = uint64(len(b))
+    fh.CompressedSize64 = uint64(len(b))
+    w, err := a.create(fh)
+    if err != nil {
+        return err
+    }
+    _, err = w.Write(b)
+    return err
+}
+
+// writeDir writes the directory entry fh, which closes the previous entry.
+func (a *zipAligner) writeDir(fh *zip.FileHeader) error {
+    _, err := a.zw.CreateHeader(fh)
+    a.last = nil
+    return err
+}
+
+// create starts the raw entry fh, padding its local header if it is
+// stored. The sizes and CRC of a stored entry are set.
+func (a *zipAligner) create(fh *zip.FileHeader) (io.Writer, error) {
+    setRawModified(fh, fh.Modified)
+    fh.Extra = stripExtra(fh.Extra, zipAlignExtraID)
+    if fh.Method != zip.Store {
+        fh.Flags |= zipFlagDescriptor
+        w, err := a.zw.CreateRaw(fh)
+        a.last = fh
+        return w, err
+    }
+    fh.Flags &^= zipFlagDescriptor
+
+    // The previous entry has nothing left to write but the data
+    // descriptor of a deflated one.
+    if err := a.zw.Flush(); err != nil {
+        return nil, err
+    }
+    start := a.off + zipLocalLen + int64(len(fh.Name)) + int64(len(fh.Extra)) + 6
+    if last := a.last; last != nil {
+        start += 16
+        if last.CompressedSize64 >= 1<<32-1 || last.UncompressedSize64 >= 1<<32-1 {
+            start += 8
+        }
+    }
+    if fh.CompressedSize64 > 1<<32-1 || fh.UncompressedSize64 > 1<<32-1 {
+        start += 20 // the writer's zip64 field
+    }
+    pad := int((int64(a.align) - start%int64(a.align)) % int64(a.align))
+    field := make([]byte, 6+pad)
+    binary.LittleEndian.PutUint16(field, zipAlignExtraID)
+    binary.LittleEndian.PutUint16(field[2:], uint16(2+pad))
+    binary.LittleEndian.PutUint16(field[4:], uint16(a.align))
+    extra := fh.Extra
+    fh.Extra = append(fh.Extra[:len(fh.Extra):len(fh.Extra)], field...)
+
+    w, err := a.zw.CreateRaw(fh)
+    a.last = nil
+    // The central directory record goes without the padding.
+    fh.Extra = extra
+    if err != nil {
+        return nil, err
+    }
+    if err := a.zw.Flush(); err != nil {
+        return nil, err
+    }
+    if a.off != start+int64(pad) {
+        return nil, fmt.Errorf("archive: cannot align %s: content at %d, expected %d", fh.Name, a.off, start+int64(pad))
+    }
+    return w, nil
+}
+
+// clamp32 returns n, or 0xffffffff if it does not fit in 32 bits.
+func clamp32(n uint64) uint32 {
+    if n > 1<<32-1 {
+        return 1<<32 - 1
+    }
+    return uint32(n)
+}
+
+// checkAlign validates CreateOptions.Align.
+func checkAlign(align int) error {
+    if align < 0 || align > 1<<16-1 || align&(align-1) != 0 {
+        return fmt.Errorf("archive: alignment %d is not a power of two below 65536", align)
+    }
+    return nil
+}
@@ -0,0 +1,90 @@
+package archive
+
+import (
+    "archive/zip"
+    "fmt"
+    "io"
+)
+
+// Compression methods beyond zip.Store and zip.Deflate, as numbered by the
+// zip specification. They are built into ZipStreamReader, RecoverZip and
+// the extractors, and registered with every zip.Reader and zip.Writer this
+// package creates; RegisterDecompressors and RegisterCompressors add them
+// to others. The archive/zip defaults are left alone.
+const (
+    Deflate64 uint16 = 9  // Deflate with a 64 KiB window
+    BZIP2     uint16 = 12 // bzip2
+    LZMA      uint16 = 14 // LZMA with the end of stream marker
+    Zstd      uint16 = 93 // Zstandard
+)
+
+// zipFlagLZMAEOS marks LZMA data terminated by an end of stream marker,
+// which the LZMA compressor always writes.
+const zipFlagLZMAEOS = 0x2
+
+var methodNames = map[uint16]string{
+    zip.Store:   "store",
+    1:           "shrink",
+    6:           "implode",
+    zip.Deflate: "deflate",
+    Deflate64:   "deflate64",
+    BZIP2:       "bzip2",
+    LZMA:        "lzma",
+    95:          "xz",
+    96:          "jpeg",
+    97:          "wavpack",
+    98:          "ppmd",
+    99:          "aes",
+    Zstd:        "zstd",
+    DictDeflate: "deflate with preset dictionary",
+}
+
+// RegisterDecompressors registers the decompressors for Deflate64, BZIP2,
+// LZMA and Zstd with zr.
+func RegisterDecompressors(zr *zip.Reader) {
+    zr.RegisterDecompressor(Deflate64, newDeflate64Reader)
+    zr.RegisterDecompressor(BZIP2, newBzip2Reader)
+    zr.RegisterDecompressor(LZMA, newLZMAReader)
+    zr.RegisterDecompressor(Zstd, newZstdReader)
+}
+
+// RegisterCompressors registers the compressors for Deflate64, BZIP2,
+// LZMA and Zstd with zw.
+func RegisterCompressors(zw *zip.Writer) {
+    zw.RegisterCompressor(Deflate64, newDeflate64Writer)
+    zw.RegisterCompressor(BZIP2, newBzip2Writer)
+    zw.RegisterCompressor(LZMA, newLZMAWriter)
+    zw.RegisterCompressor(Zstd, newZstdWriter)
+}
+
+// newZipReader is zip.NewReader with the methods of this package.
+func newZipReader(r io.ReaderAt, size int64) (*zip.Reader, error) {
+    zr, err := zip.NewReader(r, size)
+    if err != nil {
+        return nil, err
+    }
+    RegisterDecompressors(zr)
+    return zr, nil
+}
+
+// newZipWriter is zip.NewWriter with the methods of this package.
+func newZipWriter(w io.Writer) *zip.Writer {
+    zw := zip.NewWriter(w)
+    RegisterCompressors(zw)
+    return zw
+}
+
+// MethodError reports an entry compressed with a method that has no
+// decompressor. It unwraps to zip.ErrAlgorithm.
+type MethodError struct {
+    Method uint16
+}
+
+func (e *MethodError) Error() string {
+    if name, ok := methodNames[e.Method]; ok {
+        return fmt.Sprintf("archive: unsupported compression method %d (%s)", e.Method, name)
+    }
+    return fmt.Sprintf("archive: unsupported compression method %d", e.Method)
+}
+
+func (e *MethodError) Unwrap() error { return zip.ErrAlgorithm }
@@ -0,0 +1,139 @@
+package archive
+
+import (
+    "archive/zip"
+    "bytes"
+    "context"
+    "errors"
+    "hash/crc32"
+    "io"
+    "io/ioutil"
+    "os"
+    "path/filepath"
+    "strings"
+    "testing"
+)
+
+func TestZipMethods(t *testing.T) {
+    body := strings.Repeat("Gopher names:\nGeorge\nGeoffrey\nGonzo\n", 3000)
+    for _, method := range []uint16{Deflate64, BZIP2, LZMA, Zstd} {
+        // The stream writer uses data descriptors, so the readers
+        // depend on the decompressor stopping at the end of its data.
+        var buf bytes.Buffer
+        w := NewZipStreamWriter(&buf)
+        for _, name := range []string{"a.txt", "b.txt"} {
+            fw, err := w.CreateHeader(&zip.FileHeader{Name: name, Method: method})
+            if err != nil {
+                t.Fatal(err)
+            }
+            io.WriteString(fw, name+body)
+        }
+        if err := w.Close(); err != nil {
+            t.Fatal(err)
+        }
+        data := buf.Bytes()
+
+        got, _, err := readStream(bytes.NewReader(data))
+        if err != nil {
+            t.Fatalf("method %d: stream: %v", method, err)
+        }
+        if got["b.txt"] != "b.txt"+body {
+            t.Errorf("method %d: stream: wrong content", method)
+        }
+
+        zr, err := zip.NewReader(bytes.NewReader(data), int64(len(data)))
+        if err != nil {
+            t.Fatal(err)
+        }
+        // The methods are not registered with archive/zip itself.
+        if _, err := zr.File[0].Open(); err != zip.ErrAlgorithm {
+            t.Errorf("method %d: unregistered Open = %v", method, err)
+        }
+        RegisterDecompressors(zr)
+        for _, f := range zr.File {
+            rc, err := f.Open()
+            if err != nil {
+                t.Fatalf("method %d: %v", method, err)
+            }
+            b, err := ioutil.ReadAll(rc)
+            if err != nil || string(b) != f.Name+body {
+                t.Errorf("method %d: zip.Reader: %s: %v", method, f.Name, err)
+            }
+        }
+
+        rep, err := RecoverZip(context.Background(), bytes.NewReader(data), int64(len(data)))
+        if err != nil {
+            t.Fatal(err)
+        }
+        if len(rep.Files) != 2 || len(rep.Failed) != 0 {
+            t.Errorf("method %d: recovered %d files, failures %v", method, len(rep.Files), rep.Failed)
+        }
+    }
+}
+
+// unsupportedZip returns an archive whose first entry uses PPMd.
+func unsupportedZip(t *testing.T) []byte {
+    var buf bytes.Buffer
+    zw := zip.NewWriter(&buf)
+    raw := []byte("not really ppmd")
+    fw, err := zw.CreateRaw(&zip.FileHeader{
+        Name:               "ppmd.bin",
+        Method:             98,
+        CRC32:              crc32.ChecksumIEEE(raw),
+        CompressedSize64:   uint64(len(raw)),
+        UncompressedSize64: uint64(len(raw)),
+    })
+    if err != nil {
+        t.Fatal(err)
+    }
+    fw.Write(raw)
+    fw, err = zw.Create("a.txt")
+    if err != nil {
+        t.Fatal(err)
+    }
+    io.WriteString(fw, "gopher")
+    if err := zw.Close(); err != nil {
+        t.Fatal(err)
+    }
+    return buf.Bytes()
+}
+
+func TestZipStreamUnsupportedMethod(t *testing.T) {
+    zr := NewZipStreamReader(bytes.NewReader(unsupportedZip(t)))
+    fh, err := zr.Next()
+    if err != nil || fh.Name != "ppmd.bin" {
+        t.Fatalf("Next = %v, %v", fh, err)
+    }
+    var me *MethodError
+    if _, err := ioutil.ReadAll(zr); !errors.As(err, &me) || me.Method != 98 {
+        t.Fatalf("Read error %v, want a MethodError", err)
+    }
+    if fh, err = zr.Next(); err != nil || fh.Name != "a.txt" {
+        t.Fatalf("Next = %v, %v", fh, err)
+    }
+    if b, err := ioutil.ReadAll(zr); err != nil || string(b) != "gopher" {
+        t.Fatalf("a.txt: %q, %v", b, err)
+    }
+    if _, err := zr.Next(); err != io.EOF {
+        t.Fatalf("Next = %v, want io.EOF", err)
+    }
+}
+
+func TestExtractZipUnsupportedMethod(t *testing.T) {
+    data := unsupportedZip(t)
+    dir := tempDir(t)
+    err := ExtractZip(context.Background(), bytes.NewReader(data), int64(len(data)), dir, nil)
+    var se *SkippedError
+    if !errors.As(err, &se) || len(se.Entries) != 1 || se.Entries[0].Name != "ppmd.bin" {
+        t.Fatalf("got %v, want a SkippedError for ppmd.bin", err)
+    }
+    if !errors.Is(err, zip.ErrAlgorithm) {
+        t.Errorf("%v does not wrap zip.ErrAlgorithm", err)
+    }
+    if b, err := ioutil.ReadFile(filepath.Join(dir, "a.txt")); err != nil || string(b) != "gopher" {
+        t.Errorf("a.txt: %q, %v", b, err)
+    }
+    if _, err := os.Stat(filepath.Join(dir, "ppmd.bin")); !os.IsNotExist(err) {
+        t.Errorf("ppmd.bin was extracted: %v", err)
+    }
+}
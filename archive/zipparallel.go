@@ -0,0 +1,239 @@
+package archive
+
+import (
+    "archive/tar"
+    "archive/zip"
+    "context"
+    "errors"
+    "fmt"
+    "io/ioutil"
+    "os"
+    "path/filepath"
+    "sync"
+    "time"
+)
+
+// ExtractErrors is returned when several entries of a concurrent
+// extraction failed. As with any failed extraction, everything created
+// has been removed.
+type ExtractErrors struct {
+    Entries []*EntryError
+}
+
+func (e *ExtractErrors) Error() string {
+    return fmt.Sprintf("%v (and %d more failed entries)", e.Entries[0], len(e.Entries)-1)
+}
+
+// Unwrap returns the first entry's error.
+func (e *ExtractErrors) Unwrap() error { return e.Entries[0] }
+
+// zipJob is a regular file entry waiting for a worker.
+type zipJob struct {
+    f      *zip.File
+    hdr    *tar.Header
+    target string
+    d      ExtractDecision
+}
+
+// extractZipParallel extracts zip entries with n workers, returning those
+// skipped for their compression method. Directories are created and every
+// target is resolved first, in archive order, so the workers never race
+// on the shape of the tree; links are made last, once their targets
+// exist. A link counts as an existing file for the entries after it, and
+// one overwritten by a file is not made. Directory metadata is left to
+// x.finish as usual.
+func extractZipParallel(x *extractor, files []*zip.File, n int) ([]*EntryError, error) {
+    var jobs []zipJob
+    var links []*zip.File
+    latest := map[string]int{}
+    linkAt := map[string]int{}
+    x.planned = map[string]time.Time{}
+    for _, f := range files {
+        if err := x.ctx.Err(); err != nil {
+            return nil, err
+        }
+        hdr := zipHeader(&f.FileHeader)
+        switch hdr.Typeflag {
+        case tar.TypeDir:
+            x.tk.begin(f.Name)
+            if err := x.extract(hdr, nil); err != nil {
+                return nil, err
+            }
+            x.tk.entryDone()
+        case tar.TypeReg:
+            target, err := x.target(f.Name)
+            if err != nil {
+                return nil, err
+            }
+            if target == "" {
+                x.tk.entryDone()
+                continue
+            }
+            err = x.mkdirAll(hdr, filepath.Dir(target))
+            var d ExtractDecision
+            if err == nil {
+                // Files planned so far count as existing, so that several
+                // entries with the same name are decided as they would
+                // be in order.
+                target, d, err = x.place(hdr, target)
+            }
+            if err == errSkipEntry || err == nil && target == "" {
+                x.tk.entryDone()
+                continue
+            }
+            if err != nil {
+                return nil, err
+            }
+            // An entry overwriting one planned before takes its place.
+            if i, ok := latest[target]; ok {
+                jobs[i].f = nil
+                x.tk.entryDone()
+            }
+            if i, ok := linkAt[target]; ok {
+                links[i] = nil
+                delete(linkAt, target)
+                x.tk.entryDone()
+            }
+            latest[target] = len(jobs)
+            x.planned[target] = hdr.ModTime
+            jobs = append(jobs, zipJob{f: f, hdr: hdr, target: target, d: d})
+        default:
+            // A bad name fails when the link is made.
+            if target, err := x.target(f.Name); err == nil && target != "" {
+                linkAt[target] = len(links)
+                x.planned[target] = hdr.ModTime
+            }
+            links = append(links, f)
+        }
+    }
+
+    ctx, cancel := context.WithCancel(x.ctx)
+    defer cancel()
+    errs := make([]error, len(jobs))
+    next := make(chan int)
+    var wg sync.WaitGroup
+    for w := 0; w < n && w < len(jobs); w++ {
+        wg.Add(1)
+        go func() {
+            defer wg.Done()
+            for i := range next {
+                err := x.writeZipJob(ctx, jobs[i])
+                if err == nil {
+                    x.tk.entryDone()
+                    continue
+                }
+                errs[i] = err
+                if _, ok := skippable(err); !ok {
+                    cancel()
+                }
+            }
+        }()
+    }
+feed:
+    for i := range jobs {
+        if jobs[i].f == nil {
+            continue
+        }
+        select {
+        case next <- i:
+        case <-ctx.Done():
+            break feed
+        }
+    }
+    close(next)
+    wg.Wait()
+    x.planned = nil
+    if err := x.ctx.Err(); err != nil {
+        return nil, err
+    }
+
+    var skipped, failed []*EntryError
+    for i, err := range errs {
+        if err == nil {
+            continue
+        }
+        if ee, ok := skippable(err); ok {
+            skipped = append(skipped, ee)
+            continue
+        }
+        if errors.Is(err, context.Canceled) {
+            // Stopped because another entry failed.
+            continue
+        }
+        ee, ok := err.(*EntryError)
+        if !ok {
+            ee = &EntryError{Name: jobs[i].f.Name, Err: err}
+        }
+        failed = append(failed, ee)
+    }
+    switch len(failed) {
+    case 0:
+    case 1:
+        return nil, failed[0]
+    default:
+        return nil, &ExtractErrors{Entries: failed}
+    }
+
+    made := links[:0]
+    for _, f := range links {
+        if f != nil {
+            made = append(made, f)
+        }
+    }
+    more, err := extractZipFiles(x, made)
+    if err != nil {
+        return nil, err
+    }
+    return append(skipped, more...), nil
+}
+
+// writeZipJob writes a regular file through a temporary file renamed into
+// place, so that a failure never leaves it half written.
+func (x *extractor) writeZipJob(ctx context.Context, j zipJob) (err error) {
+    x.tk.begin(j.f.Name)
+    rc, err := x.openZip(j.f)
+    if err == zip.ErrAlgorithm {
+        err = &MethodError{Method: j.f.Method}
+    }
+    if err != nil {
+        return &EntryError{Name: j.f.Name, Err: err}
+    }
+    defer rc.Close()
+    tmp, err := ioutil.TempFile(filepath.Dir(j.target), "."+filepath.Base(j.target)+".")
+    if err != nil {
+        return err
+    }
+    defer func() {
+        if err != nil {
+            os.Remove(tmp.Name())
+        }
+    }()
+    err = x.copyTo(ctx, tmp, rc)
+    if cerr := tmp.Close(); err == nil {
+        err = cerr
+    }
+    if err != nil {
+        return err
+    }
+    if err := setOwner(tmp.Name(), &j.d); err != nil {
+        return err
+    }
+    if err := os.Chmod(tmp.Name(), j.d.Mode); err != nil {
+        return err
+    }
+    if !j.hdr.ModTime.IsZero() {
+        if err := os.Chtimes(tmp.Name(), j.hdr.ModTime, j.hdr.ModTime); err != nil {
+            return err
+        }
+    }
+    _, statErr := os.Lstat(j.target)
+    if err := os.Rename(tmp.Name(), j.target); err != nil {
+        return err
+    }
+    if os.IsNotExist(statErr) {
+        x.mu.Lock()
+        x.created = append(x.created, j.target)
+        x.mu.Unlock()
+    }
+    return nil
+}
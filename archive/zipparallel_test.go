@@ -0,0 +1,169 @@
+package archive
+
+import (
+    "archive/zip"
+    "bytes"
+    "context"
+    "errors"
+    "fmt"
+    "io/ioutil"
+    "os"
+    "path/filepath"
+    "testing"
+    "time"
+)
+
+func manyFiles(n int) map[string]string {
+    files := map[string]string{}
+    for i := 0; i < n; i++ {
+        files[fmt.Sprintf("dir%d/file%03d.txt", i%10, i)] = fmt.Sprintf("content of file %d\n", i)
+    }
+    return files
+}
+
+func TestExtractZipConcurrent(t *testing.T) {
+    src := tempDir(t)
+    files := manyFiles(300)
+    writeTree(t, src, files)
+    old := time.Date(2015, 6, 7, 8, 9, 10, 0, time.UTC)
+    os.Chtimes(filepath.Join(src, "dir3"), old, old)
+    if err := os.Symlink("dir1/file001.txt", filepath.Join(src, "link")); err != nil {
+        t.Skip(err)
+    }
+    arc := filepath.Join(tempDir(t), "many.zip")
+    if err := Create(context.Background(), arc, src, nil); err != nil {
+        t.Fatal(err)
+    }
+
+    dst := tempDir(t)
+    var last Progress
+    opts := &ExtractOptions{Concurrency: 8, Progress: func(p Progress) { last = p }}
+    if err := Extract(context.Background(), arc, dst, opts); err != nil {
+        t.Fatal(err)
+    }
+    checkTree(t, dst, files)
+    if link, err := os.Readlink(filepath.Join(dst, "link")); err != nil || link != "dir1/file001.txt" {
+        t.Errorf("link = %q, %v", link, err)
+    }
+    if fi, err := os.Stat(filepath.Join(dst, "dir3")); err != nil || !fi.ModTime().Equal(old) {
+        t.Errorf("dir3 mtime not restored: %v", err)
+    }
+    if !last.Done || last.Entries != 300+10+1 {
+        t.Errorf("last progress = %+v", last)
+    }
+}
+
+// corruptZip returns a zip archive of stored files in which the content
+// of the named files is damaged.
+func corruptZip(t *testing.T, files map[string]string, bad ...string) []byte {
+    var buf bytes.Buffer
+    zw := zip.NewWriter(&buf)
+    for name, body := range files {
+        fw, err := zw.CreateHeader(&zip.FileHeader{Name: name, Method: zip.Store})
+        if err != nil {
+            t.Fatal(err)
+        }
+        fw.Write([]byte(body))
+    }
+    zw.Close()
+    b := buf.Bytes()
+    for _, name := range bad {
+        i := bytes.Index(b, []byte(files[name]))
+        b[i] ^= 0xff
+    }
+    return b
+}
+
+func TestExtractZipConcurrentFailure(t *testing.T) {
+    files := manyFiles(50)
+    b := corruptZip(t, files, "dir3/file003.txt", "dir7/file027.txt")
+    dst := tempDir(t)
+    writeTree(t, dst, map[string]string{"dir7/file027.txt": "precious"})
+
+    err := ExtractZip(context.Background(), bytes.NewReader(b), int64(len(b)), dst, &ExtractOptions{Concurrency: 4})
+    if !errors.Is(err, zip.ErrChecksum) {
+        t.Fatalf("err = %v, want a checksum error", err)
+    }
+    var many *ExtractErrors
+    if errors.As(err, &many) && len(many.Entries) > 2 {
+        t.Errorf("too many errors: %v", many.Entries)
+    }
+    // Everything extracted is removed, and the existing file was never
+    // half overwritten.
+    checkTree(t, dst, map[string]string{"dir7/file027.txt": "precious"})
+    infos, _ := ioutil.ReadDir(filepath.Join(dst, "dir7"))
+    if len(infos) != 1 {
+        t.Errorf("dir7 holds %d entries, want 1", len(infos))
+    }
+}
+
+func TestExtractZipConcurrentCancel(t *testing.T) {
+    b := corruptZip(t, manyFiles(100))
+    dst := tempDir(t)
+    ctx, cancel := context.WithCancel(context.Background())
+    opts := &ExtractOptions{Concurrency: 4, Progress: func(p Progress) {
+        if p.Entries == 20 {
+            cancel()
+        }
+    }}
+    if err := ExtractZip(ctx, bytes.NewReader(b), int64(len(b)), dst, opts); err != context.Canceled {
+        t.Fatalf("ExtractZip = %v, want context.Canceled", err)
+    }
+    checkTree(t, dst, nil)
+}
+
+func TestExtractZipConcurrentLinkAndFile(t *testing.T) {
+    // A link and a file with the same name end as they do in order.
+    var buf bytes.Buffer
+    zw := zip.NewWriter(&buf)
+    for _, e := range []struct {
+        name, body string
+        link       bool
+    }{
+        {"x", "elsewhere", true},
+        {"x", "file x", false},
+        {"y", "file y", false},
+        {"y", "elsewhere", true},
+    } {
+        fh := &zip.FileHeader{Name: e.name, Method: zip.Store}
+        fh.SetMode(0644)
+        if e.link {
+            fh.SetMode(os.ModeSymlink | 0777)
+        }
+        fw, _ := zw.CreateHeader(fh)
+        fw.Write([]byte(e.body))
+    }
+    zw.Close()
+    b := buf.Bytes()
+    tree := func(dir string) map[string]string {
+        got := map[string]string{}
+        infos, _ := ioutil.ReadDir(dir)
+        for _, fi := range infos {
+            p := filepath.Join(dir, fi.Name())
+            if fi.Mode()&os.ModeSymlink != 0 {
+                link, _ := os.Readlink(p)
+                got[fi.Name()] = "-> " + link
+            } else {
+                body, _ := ioutil.ReadFile(p)
+                got[fi.Name()] = string(body)
+            }
+        }
+        return got
+    }
+    for _, policy := range []ExistingPolicy{Overwrite, SkipExisting, RenameNew} {
+        var want map[string]string
+        for _, n := range []int{1, 4} {
+            dst := tempDir(t)
+            opts := &ExtractOptions{Existing: policy, Concurrency: n}
+            if err := ExtractZip(context.Background(), bytes.NewReader(b), int64(len(b)), dst, opts); err != nil {
+                t.Fatal(err)
+            }
+            got := tree(dst)
+            if n == 1 {
+                want = got
+            } else if fmt.Sprint(got) != fmt.Sprint(want) {
+                t.Errorf("policy %d: concurrent %v, in order %v", policy, got, want)
+            }
+        }
+    }
+}
@@ -0,0 +1,375 @@
+package archive
+
+import (
+    "archive/zip"
+    "bufio"
+    "bytes"
+    "context"
+    "encoding/binary"
+    "errors"
+    "hash/crc32"
+    "io"
+    "io/ioutil"
+)
+
+var (
+    // ErrNoLocalHeader is reported for central directory entries whose
+    // local header could not be found.
+    ErrNoLocalHeader = errors.New("archive: no intact local header")
+    // ErrEncrypted is reported for encrypted entries, which cannot be verified.
+    ErrEncrypted = errors.New("archive: entry is encrypted")
+)
+
+// RecoveredFile is a zip entry rebuilt from its local file header and
+// data descriptor. Its content has been verified against its CRC-32.
+type RecoveredFile struct {
+    zip.FileHeader
+    Offset     int64 // offset of the local file header
+    DataOffset int64 // offset of the compressed data
+    ra         io.ReaderAt
+}
+
+// Open returns a reader for the decompressed content.
+func (f *RecoveredFile) Open() (io.ReadCloser, error) {
+    dcomp := zipDecompressor(f.Method)
+    if dcomp == nil {
+        return nil, &MethodError{Method: f.Method}
+    }
+    return dcomp(f.OpenRaw()), nil
+}
+
+// OpenRaw returns a reader for the content as stored, without decompressing.
+func (f *RecoveredFile) OpenRaw() io.Reader {
+    return io.NewSectionReader(f.ra, f.DataOffset, int64(f.CompressedSize64))
+}
+
+// RecoveryFailure describes an entry that could not be recovered.
+type RecoveryFailure struct {
+    Name   string
+    Offset int64 // offset of its local header, or -1 if none was found
+    Err    error
+}
+
+// RecoveryReport is the result of RecoverZip.
+type RecoveryReport struct {
+    Files  []*RecoveredFile
+    Failed []RecoveryFailure
+}
+
+// RecoverZip rebuilds the entries of a damaged zip archive without relying
+// on its central directory. It scans r for local file header signatures,
+// takes sizes from the local headers or their data descriptors, and keeps
+// every entry whose content still matches its CRC-32. Central directory
+// headers that survive are used to restore file modes and comments, and
+// entries they list that could not be found are reported as failures.
+func RecoverZip(ctx context.Context, r io.ReaderAt, size int64) (*RecoveryReport, error) {
+    rep := &RecoveryReport{}
+    central := scanCentral(r, size)
+    found := map[int64]bool{}
+    for pos := int64(0); ; {
+        if err := ctx.Err(); err != nil {
+            return nil, err
+        }
+        off := findSig(r, pos, size, zipLocalSig)
+        if off < 0 {
+            break
+        }
+        f, end, err := recoverEntry(r, size, off)
+        switch {
+        case f == nil:
+            // Not a plausible header, just a signature inside some data.
+            pos = off + 4
+        case err != nil:
+            rep.Failed = append(rep.Failed, RecoveryFailure{Name: f.Name, Offset: off, Err: err})
+            pos = off + 4
+        default:
+            if ch := central[off]; ch != nil && ch.Name == f.Name {
+                f.CreatorVersion = ch.CreatorVersion
+                f.ExternalAttrs = ch.ExternalAttrs
+                f.Comment = ch.Comment
+            }
+            found[off] = true
+            rep.Files = append(rep.Files, f)
+            pos = end
+        }
+    }
+    for off, ch := range central {
+        if !found[off] && !failedAt(rep.Failed, off) {
+            rep.Failed = append(rep.Failed, RecoveryFailure{Name: ch.Name, Offset: -1, Err: ErrNoLocalHeader})
+        }
+    }
+    return rep, nil
+}
+
+func failedAt(failed []RecoveryFailure, off int64) bool {
+    for _, f := range failed {
+        if f.Offset == off {
+            return true
+        }
+    }
+    return false
+}
+
+// findSig returns the offset of the first occurrence of sig at or after
+// pos, or -1.
+func findSig(r io.ReaderAt, pos, size int64, sig uint32) int64 {
+    var s [4]byte
+    binary.LittleEndian.PutUint32(s[:], sig)
+    buf := make([]byte, 64<<10)
+    for pos < size {
+        n, _ := r.ReadAt(buf, pos)
+        if n < len(s) {
+            return -1
+        }
+        if i := bytes.Index(buf[:n], s[:]); i >= 0 {
+            return pos + int64(i)
+        }
+        pos += int64(n - len(s) + 1)
+    }
+    return -1
+}
+
+// scanCentral collects whatever central directory headers can be parsed,
+// keyed by the local header offset they point at.
+func scanCentral(r io.ReaderAt, size int64) map[int64]*zip.FileHeader {
+    headers := map[int64]*zip.FileHeader{}
+    for pos := int64(0); ; {
+        off := findSig(r, pos, size, zipCentralSig)
+        if off < 0 {
+            return headers
+        }
+        pos = off + 4
+        var buf [zipCentralLen - 4]byte
+        if _, err := r.ReadAt(buf[:], off+4); err != nil {
+            continue
+        }
+        fh, nameLen, extraLen, commentLen, loc := decodeCentral(buf[:])
+        d := make([]byte, nameLen+extraLen+commentLen)
+        if _, err := r.ReadAt(d, off+zipCentralLen); err != nil || nameLen == 0 {
+            continue
+        }
+        loc = finishCentral(fh, d, nameLen, extraLen, loc)
+        if loc >= 0 && loc < off {
+            headers[loc] = fh
+        }
+    }
+}
+
+// recoverEntry rebuilds the entry whose local header is at off. It returns
+// a nil file if the header is not plausible, and otherwise the file and
+// the offset just past its data, or an error explaining why its content
+// could not be verified.
+func recoverEntry(r io.ReaderAt, size, off int64) (*RecoveredFile, int64, error) {
+    var buf [zipLocalLen - 4]byte
+    if _, err := r.ReadAt(buf[:], off+4); err != nil {
+        return nil, 0, err
+    }
+    fh, nameLen, extraLen := decodeLocal(buf[:])
+    if nameLen == 0 || nameLen > 4096 || fh.ReaderVersion&0xff > 63 {
+        return nil, 0, zip.ErrFormat
+    }
+    d := make([]byte, nameLen+extraLen)
+    if _, err := r.ReadAt(d, off+zipLocalLen); err != nil {
+        return nil, 0, err
+    }
+    zip64 := finishLocal(fh, d, nameLen)
+    f := &RecoveredFile{
+        FileHeader: *fh,
+        Offset:     off,
+        DataOffset: off + zipLocalLen + int64(nameLen+extraLen),
+        ra:         r,
+    }
+    if f.Flags&0x1 != 0 {
+        return f, 0, ErrEncrypted
+    }
+    dcomp := zipDecompressor(f.Method)
+    if dcomp == nil {
+        return f, 0, &MethodError{Method: f.Method}
+    }
+    end := f.DataOffset + int64(f.CompressedSize64)
+    switch {
+    case f.Flags&zipFlagDescriptor == 0:
+        if end > size {
+            return f, 0, io.ErrUnexpectedEOF
+        }
+    case f.Method == zip.Store:
+        var err error
+        if end, err = findStoredEnd(r, size, f, zip64); err != nil {
+            return f, 0, err
+        }
+    default:
+        var err error
+        if end, err = findCompressedEnd(r, size, f, dcomp, zip64); err != nil {
+            return f, 0, err
+        }
+    }
+    rc, err := f.Open()
+    if err != nil {
+        return f, 0, err
+    }
+    defer rc.Close()
+    h := crc32.NewIEEE()
+    n, err := copyBuffer(h, rc)
+    if err != nil {
+        return f, 0, err
+    }
+    if h.Sum32() != f.CRC32 || uint64(n) != f.UncompressedSize64 {
+        return f, 0, zip.ErrChecksum
+    }
+    return f, end, nil
+}
+
+// findStoredEnd locates the data descriptor of a stored entry by looking
+// for a descriptor whose sizes match its distance from the data, and sets
+// the entry's sizes and CRC-32 from it.
+func findStoredEnd(r io.ReaderAt, size int64, f *RecoveredFile, zip64 bool) (int64, error) {
+    for pos := f.DataOffset; ; {
+        c := findSig(r, pos, size, zipDescriptorSig)
+        if c < 0 {
+            return 0, io.ErrUnexpectedEOF
+        }
+        pos = c + 1
+        n := uint64(c - f.DataOffset)
+        var b [20]byte
+        if _, err := r.ReadAt(b[:], c+4); err != nil && err != io.EOF {
+            return 0, err
+        }
+        if !zip64 && n <= 0xffffffff {
+            if uint64(binary.LittleEndian.Uint32(b[4:])) == n && uint64(binary.LittleEndian.Uint32(b[8:])) == n {
+                setDescriptor(f, binary.LittleEndian.Uint32(b[:]), n, n)
+                return c + 16, nil
+            }
+            continue
+        }
+        if binary.LittleEndian.Uint64(b[4:]) == n && binary.LittleEndian.Uint64(b[12:]) == n {
+            setDescriptor(f, binary.LittleEndian.Uint32(b[:]), n, n)
+            return c + 24, nil
+        }
+    }
+}
+
+// findCompressedEnd decompresses an entry to find where its data ends,
+// then reads the data descriptor that follows.
+func findCompressedEnd(r io.ReaderAt, size int64, f *RecoveredFile, dcomp zip.Decompressor, zip64 bool) (int64, error) {
+    cbr := &countingByteReader{br: bufio.NewReader(io.NewSectionReader(r, f.DataOffset, size-f.DataOffset))}
+    rc := dcomp(cbr)
+    usize, err := io.Copy(ioutil.Discard, rc)
+    rc.Close()
+    if err != nil {
+        return 0, err
+    }
+    pos := f.DataOffset + cbr.n
+    var b [24]byte
+    if _, err := r.ReadAt(b[:], pos); err != nil && err != io.EOF {
+        return 0, err
+    }
+    desc := b[:]
+    descLen := int64(0)
+    if binary.LittleEndian.Uint32(desc) == zipDescriptorSig {
+        desc, descLen = desc[4:], 4
+    }
+    csize := uint64(cbr.n)
+    if zip64 || csize > 0xffffffff || uint64(usize) > 0xffffffff {
+        setDescriptor(f, binary.LittleEndian.Uint32(desc), binary.LittleEndian.Uint64(desc[4:]), binary.LittleEndian.Uint64(desc[12:]))
+        descLen += 20
+    } else {
+        setDescriptor(f, binary.LittleEndian.Uint32(desc), uint64(binary.LittleEndian.Uint32(desc[4:])), uint64(binary.LittleEndian.Uint32(desc[8:])))
+        descLen += 12
+    }
+    if f.CompressedSize64 != csize || f.UncompressedSize64 != uint64(usize) {
+        return 0, zip.ErrChecksum
+    }
+    return pos + descLen, nil
+}
+
+func setDescriptor(f *RecoveredFile, crc uint32, csize, usize uint64) {
+    f.CRC32 = crc
+    f.CompressedSize64, f.UncompressedSize64 = csize, usize
+    f.CompressedSize = uint32(min64(csize, 0xffffffff))
+    f.UncompressedSize = uint32(min64(usize, 0xffffffff))
+}
+
+// countingByteReader counts the bytes a decompressor consumes.
+type countingByteReader struct {
+    br *bufio.Reader
+    n  int64
+}
+
+func (r *countingByteReader) Read(p []byte) (int, error) {
+    n, err := r.br.Read(p)
+    r.n += int64(n)
+    return n, err
+}
+
+func (r *countingByteReader) ReadByte() (byte, error) {
+    c, err := r.br.ReadByte()
+    if err == nil {
+        r.n++
+    }
+    return c, err
+}
+
+// WriteRepaired writes a well-formed archive holding the recovered
+// entries. Their data is copied as stored, without recompression.
+func (rep *RecoveryReport) WriteRepaired(w io.Writer) error {
+    zw := newZipWriter(w)
+    for _, f := range rep.Files {
+        fh := f.FileHeader
+        fh.Flags &^= zipFlagDescriptor
+        // The writer adds its own zip64 field.
+        fh.Extra = stripExtra(fh.Extra, zip64ExtraID)
+        setRawModified(&fh, fh.Modified)
+        fw, err := zw.CreateRaw(&fh)
+        if err != nil {
+            return err
+        }
+        if _, err := copyBuffer(fw, f.OpenRaw()); err != nil {
+            return err
+        }
+    }
+    return zw.Close()
+}
+
+// Extract unpacks the recovered entries into the directory dst.
+func (rep *RecoveryReport) Extract(ctx context.Context, dst string, opts *ExtractOptions) (err error) {
+    if opts == nil {
+        opts = &ExtractOptions{}
+    }
+    tk := newTracker(opts.Progress, len(rep.Files), -1)
+    x := newExtractor(ctx, dst, opts, tk)
+    defer func() {
+        if err != nil {
+            x.cleanup()
+        }
+    }()
+    for _, f := range rep.Files {
+        tk.begin(f.Name)
+        if err := extractZipEntry(x, &f.FileHeader, f.Open); err != nil {
+            return err
+        }
+        tk.entryDone()
+    }
+    if err := x.finish(); err != nil {
+        return err
+    }
+    tk.done()
+    return nil
+}
+
+// stripExtra returns extra without the fields whose ids are listed.
+func stripExtra(extra []byte, ids ...uint16) []byte {
+    var out []byte
+    forEachExtra(extra, func(id uint16, field []byte) {
+        for _, drop := range ids {
+            if id == drop {
+                return
+            }
+        }
+        var h [4]byte
+        binary.LittleEndian.PutUint16(h[:], id)
+        binary.LittleEndian.PutUint16(h[2:], uint16(len(field)))
+        out = append(out, h[:]...)
+        out = append(out, field...)
+    })
+    return out
+}
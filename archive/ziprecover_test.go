@@ -0,0 +1,163 @@
+package archive
+
+import (
+    "archive/zip"
+    "bytes"
+    "context"
+    "io"
+    "io/ioutil"
+    "testing"
+    "time"
+)
+
+func recoveredContents(t *testing.T, rep *RecoveryReport) map[string]string {
+    got := map[string]string{}
+    for _, f := range rep.Files {
+        rc, err := f.Open()
+        if err != nil {
+            t.Fatal(err)
+        }
+        b, err := ioutil.ReadAll(rc)
+        rc.Close()
+        if err != nil {
+            t.Fatal(err)
+        }
+        got[f.Name] = string(b)
+    }
+    return got
+}
+
+func TestRecoverZipTruncated(t *testing.T) {
+    data := buildStream(t)
+    // Cut the archive in the middle of its central directory.
+    cut := bytes.Index(data, []byte("PK\x01\x02")) + 10
+    rep, err := RecoverZip(context.Background(), bytes.NewReader(data[:cut]), int64(cut))
+    if err != nil {
+        t.Fatal(err)
+    }
+    if len(rep.Failed) != 0 {
+        t.Fatalf("failures: %+v", rep.Failed)
+    }
+    got := recoveredContents(t, rep)
+    for _, f := range streamFiles {
+        if got[f.Name] != f.Body {
+            t.Errorf("%s: got %d bytes, want %d", f.Name, len(got[f.Name]), len(f.Body))
+        }
+    }
+
+    var buf bytes.Buffer
+    if err := rep.WriteRepaired(&buf); err != nil {
+        t.Fatal(err)
+    }
+    zr, err := zip.NewReader(bytes.NewReader(buf.Bytes()), int64(buf.Len()))
+    if err != nil {
+        t.Fatal(err)
+    }
+    if len(zr.File) != len(streamFiles) {
+        t.Fatalf("repaired archive has %d files, want %d", len(zr.File), len(streamFiles))
+    }
+    for i, f := range zr.File {
+        rc, err := f.Open()
+        if err != nil {
+            t.Fatal(err)
+        }
+        b, err := ioutil.ReadAll(rc)
+        rc.Close()
+        if err != nil {
+            t.Fatal(err)
+        }
+        if string(b) != streamFiles[i].Body {
+            t.Errorf("repaired %s differs", f.Name)
+        }
+    }
+}
+
+func TestRecoverZipCorruptEntry(t *testing.T) {
+    var buf bytes.Buffer
+    zw := zip.NewWriter(&buf)
+    bodies := map[string]string{"a.txt": "first file", "b.txt": "second file", "c.txt": "third file"}
+    for _, name := range []string{"a.txt", "b.txt", "c.txt"} {
+        fw, err := zw.CreateHeader(&zip.FileHeader{Name: name, Method: zip.Store})
+        if err != nil {
+            t.Fatal(err)
+        }
+        io.WriteString(fw, bodies[name])
+    }
+    zw.Close()
+    data := buf.Bytes()
+    i := bytes.Index(data, []byte("second file"))
+    data[i] ^= 0xff
+    // Also destroy the end of central directory record.
+    data = data[:len(data)-zipEndLen]
+
+    rep, err := RecoverZip(context.Background(), bytes.NewReader(data), int64(len(data)))
+    if err != nil {
+        t.Fatal(err)
+    }
+    if len(rep.Failed) != 1 || rep.Failed[0].Name != "b.txt" || rep.Failed[0].Err != zip.ErrChecksum {
+        t.Fatalf("failures: %+v", rep.Failed)
+    }
+    got := recoveredContents(t, rep)
+    if len(got) != 2 || got["a.txt"] != bodies["a.txt"] || got["c.txt"] != bodies["c.txt"] {
+        t.Fatalf("recovered %v", got)
+    }
+
+    dir := tempDir(t)
+    if err := rep.Extract(context.Background(), dir, nil); err != nil {
+        t.Fatal(err)
+    }
+    checkTree(t, dir, map[string]string{"a.txt": bodies["a.txt"], "c.txt": bodies["c.txt"]})
+}
+
+func TestRecoverZipMissingLocalHeader(t *testing.T) {
+    var buf bytes.Buffer
+    zw := zip.NewWriter(&buf)
+    for _, name := range []string{"a.txt", "b.txt"} {
+        fw, _ := zw.Create(name)
+        io.WriteString(fw, "content of "+name)
+    }
+    zw.Close()
+    data := buf.Bytes()
+    copy(data, "XXXX") // a.txt loses its local header signature
+
+    rep, err := RecoverZip(context.Background(), bytes.NewReader(data), int64(len(data)))
+    if err != nil {
+        t.Fatal(err)
+    }
+    if len(rep.Files) != 1 || rep.Files[0].Name != "b.txt" {
+        t.Fatalf("recovered %d files", len(rep.Files))
+    }
+    if len(rep.Failed) != 1 || rep.Failed[0].Name != "a.txt" || rep.Failed[0].Err != ErrNoLocalHeader {
+        t.Fatalf("failures: %+v", rep.Failed)
+    }
+}
+
+func TestRecoverZipKeepsModified(t *testing.T) {
+    // The repaired archive keeps modification times to the second, odd
+    // seconds included, which DOS times cannot hold.
+    mtime := time.Date(2021, 3, 4, 5, 6, 7, 0, time.UTC)
+    var buf bytes.Buffer
+    zw := zip.NewWriter(&buf)
+    fh := &zip.FileHeader{Name: "a.txt", Method: zip.Deflate}
+    setZipModified(fh, mtime)
+    fw, _ := zw.CreateHeader(fh)
+    io.WriteString(fw, "content")
+    zw.Close()
+    data := buf.Bytes()
+    cut := bytes.Index(data, []byte("PK\x01\x02"))
+    rep, err := RecoverZip(context.Background(), bytes.NewReader(data[:cut]), int64(cut))
+    if err != nil || len(rep.Files) != 1 {
+        t.Fatalf("RecoverZip: %d files, %v", len(rep.Files), err)
+    }
+    buf.Reset()
+    if err := rep.WriteRepaired(&buf); err != nil {
+        t.Fatal(err)
+    }
+    zr, err := zip.NewReader(bytes.NewReader(buf.Bytes()), int64(buf.Len()))
+    if err != nil {
+        t.Fatal(err)
+    }
+    if got := zr.File[0].Modified; !got.Equal(mtime) {
+        t.Errorf("Modified = %v, want %v", got, mtime)
+    }
+}
@@ -0,0 +1,769 @@
+package archive
+
+import (
+    "archive/zip"
+    "bufio"
+    "bytes"
+    "compress/flate"
+    "encoding/binary"
+    "errors"
+    "fmt"
+    "hash"
+    "hash/crc32"
+    "io"
+    "io/ioutil"
+    "time"
+)
+
+const (
+    zipLocalSig      = 0x04034b50
+    zipCentralSig    = 0x02014b50
+    zipDescriptorSig = 0x08074b50
+    zipEndSig        = 0x06054b50
+    zip64EndSig      = 0x06064b50
+    zip64LocatorSig  = 0x07064b50
+
+    zipLocalLen   = 30 // local file header length, including the signature
+    zipCentralLen = 46 // central directory header length, including the signature
+    zipEndLen     = 22 // end of central directory record length, including the signature
+
+    zip64ExtraID   = 0x0001
+    extTimeExtraID = 0x5455
+    ntfsExtraID    = 0x000a
+
+    zipFlagDescriptor = 0x8
+)
+
+// ErrCentralMismatch is returned by ZipStreamReader when the central
+// directory does not agree with the local file headers that preceded it.
+var ErrCentralMismatch = errors.New("archive: central directory does not match local headers")
+
+// streamEntry is what ZipStreamReader remembers about each local entry so
+// the central directory can be cross-checked at the end of the stream.
+type streamEntry struct {
+    name   string
+    offset int64
+    crc    uint32
+    csize  uint64
+    usize  uint64
+}
+
+// ZipStreamReader reads a zip archive sequentially from an io.Reader,
+// without the random access zip.Reader needs. Entries are discovered from
+// their local file headers; sizes and checksums stored in trailing data
+// descriptors are supported. When the central directory is reached it is
+// checked against the entries already read.
+//
+// Local headers do not carry file modes, so the headers returned by Next
+// have no external attributes. Central returns the complete headers once
+// Next has reported io.EOF.
+type ZipStreamReader struct {
+    br      *bufio.Reader
+    off     int64 // bytes consumed from br
+    decomp  map[uint16]zip.Decompressor
+    cur     *zip.FileHeader
+    body    io.Reader
+    seen    []streamEntry
+    central []*zip.FileHeader
+    comment string
+    charset Charset
+    err     error
+}
+
+// NewZipStreamReader returns a reader for the zip archive in r.
+func NewZipStreamReader(r io.Reader) *ZipStreamReader {
+    return &ZipStreamReader{br: bufio.NewReaderSize(r, 64<<10)}
+}
+
+// RegisterDecompressor registers a decompressor for a compression method.
+// The decompressor must not read past the end of its compressed data when
+// given an io.ByteReader, or entries using data descriptors cannot be read.
+func (z *ZipStreamReader) RegisterDecompressor(method uint16, dcomp zip.Decompressor) {
+    if z.decomp == nil {
+        z.decomp = make(map[uint16]zip.Decompressor)
+    }
+    z.decomp[method] = dcomp
+}
+
+// SetCharset sets the character set of names stored without the language
+// encoding flag; see DecodeZipNames. With CharsetAuto each name is
+// guessed on its own.
+func (z *ZipStreamReader) SetCharset(cs Charset) { z.charset = cs }
+
+func (z *ZipStreamReader) decompressor(method uint16) zip.Decompressor {
+    if d := z.decomp[method]; d != nil {
+        return d
+    }
+    return zipDecompressor(method)
+}
+
+// zipDecompressor returns the built-in decompressor for method, or nil.
+// None of them read past the end of their data from an io.ByteReader.
+func zipDecompressor(method uint16) zip.Decompressor {
+    switch method {
+    case zip.Store:
+        return ioutil.NopCloser
+    case zip.Deflate:
+        return flate.NewReader
+    case Deflate64:
+        return newDeflate64Reader
+    case BZIP2:
+        return newBzip2Reader
+    case LZMA:
+        return newLZMAReader
+    case Zstd:
+        return newZstdReader
+    }
+    return nil
+}
+
+// Next advances to the next entry. It returns io.EOF after the central
+// directory has been read and verified. An entry compressed with an
+// unsupported method is still returned, but reading it fails with an
+// *EntryError wrapping a *MethodError; when such an entry uses a data
+// descriptor its end cannot be found and Next fails instead.
+func (z *ZipStreamReader) Next() (*zip.FileHeader, error) {
+    if z.err != nil {
+        return nil, z.err
+    }
+    if z.body != nil {
+        body := z.body
+        if u, ok := body.(*unsupportedEntry); ok {
+            body = u.raw
+        }
+        if _, err := io.Copy(ioutil.Discard, body); err != nil {
+            z.err = err
+            return nil, err
+        }
+    }
+    z.cur, z.body = nil, nil
+    fh, err := z.next()
+    if err != nil {
+        z.err = err
+        return nil, err
+    }
+    return fh, nil
+}
+
+// Read reads from the current entry. It returns zip.ErrChecksum if the
+// content does not match the recorded CRC-32 or sizes.
+func (z *ZipStreamReader) Read(p []byte) (int, error) {
+    if z.body == nil {
+        if z.err != nil {
+            return 0, z.err
+        }
+        return 0, io.EOF
+    }
+    return z.body.Read(p)
+}
+
+// Central returns the central directory headers. It is only valid after
+// Next has returned io.EOF.
+func (z *ZipStreamReader) Central() []*zip.FileHeader { return z.central }
+
+// Comment returns the archive comment once the stream has been read.
+func (z *ZipStreamReader) Comment() string { return z.comment }
+
+func (z *ZipStreamReader) readFull(b []byte) error {
+    n, err := io.ReadFull(z.br, b)
+    z.off += int64(n)
+    if err == io.EOF {
+        err = io.ErrUnexpectedEOF
+    }
+    return err
+}
+
+func (z *ZipStreamReader) next() (*zip.FileHeader, error) {
+    start := z.off
+    var sig [4]byte
+    if err := z.readFull(sig[:]); err != nil {
+        return nil, err
+    }
+    switch binary.LittleEndian.Uint32(sig[:]) {
+    case zipLocalSig:
+        return z.readLocal(start)
+    case zipCentralSig:
+        if err := z.readCentral(); err != nil {
+            return nil, err
+        }
+        return nil, io.EOF
+    case zipEndSig:
+        if err := z.readEnd(0); err != nil {
+            return nil, err
+        }
+        return nil, io.EOF
+    }
+    return nil, zip.ErrFormat
+}
+
+func (z *ZipStreamReader) readLocal(start int64) (*zip.FileHeader, error) {
+    var buf [zipLocalLen - 4]byte
+    if err := z.readFull(buf[:]); err != nil {
+        return nil, err
+    }
+    fh, nameLen, extraLen := decodeLocal(buf[:])
+    d := make([]byte, nameLen+extraLen)
+    if err := z.readFull(d); err != nil {
+        return nil, err
+    }
+    zip64 := finishLocal(fh, d, nameLen)
+    if z.charset != CharsetNone {
+        decodeZipName(fh, z.charset)
+    }
+
+    dcomp := z.decompressor(fh.Method)
+    if dcomp == nil {
+        err := &EntryError{Name: fh.Name, Err: &MethodError{Method: fh.Method}}
+        if fh.Flags&zipFlagDescriptor != 0 {
+            // The end of the data cannot be found without decompressing it.
+            return nil, err
+        }
+        // Report the entry, but fail reads of it; Next skips its data.
+        z.seen = append(z.seen, streamEntry{name: fh.Name, offset: start,
+            crc: fh.CRC32, csize: fh.CompressedSize64, usize: fh.UncompressedSize64})
+        raw := io.LimitReader(&countByteReader{z: z}, int64(fh.CompressedSize64))
+        z.cur, z.body = fh, &unsupportedEntry{raw: raw, err: err}
+        return fh, nil
+    }
+    e := &entryReader{z: z, fh: fh, zip64: zip64, crc: crc32.NewIEEE()}
+    z.seen = append(z.seen, streamEntry{name: fh.Name, offset: start})
+    cr := &countByteReader{z: z}
+    var raw io.Reader
+    switch {
+    case fh.Flags&zipFlagDescriptor == 0:
+        raw = io.LimitReader(cr, int64(fh.CompressedSize64))
+    case fh.Method == zip.Store:
+        raw = &storedScanner{z: z, zip64: zip64, crc: crc32.NewIEEE()}
+    default:
+        // The decompressor finds the end of the data itself.
+        raw = cr
+    }
+    e.start = z.off
+    e.rc = dcomp(raw)
+    z.cur, z.body = fh, e
+    return fh, nil
+}
+
+// unsupportedEntry is the body of an entry whose compression method has no
+// decompressor.
+type unsupportedEntry struct {
+    raw io.Reader
+    err error
+}
+
+func (u *unsupportedEntry) Read([]byte) (int, error) { return 0, u.err }
+
+// countByteReader reads from the underlying bufio.Reader and keeps the
+// stream offset up to date. It implements io.ByteReader so decompressors
+// such as compress/flate do not read past the end of their data.
+type countByteReader struct{ z *ZipStreamReader }
+
+func (r *countByteReader) Read(p []byte) (int, error) {
+    n, err := r.z.br.Read(p)
+    r.z.off += int64(n)
+    return n, err
+}
+
+func (r *countByteReader) ReadByte() (byte, error) {
+    c, err := r.z.br.ReadByte()
+    if err == nil {
+        r.z.off++
+    }
+    return c, err
+}
+
+// storedScanner returns the content of a stored entry whose size is only
+// given by a trailing data descriptor. It stops at the first descriptor
+// signature whose CRC-32 and size agree with the bytes before it.
+type storedScanner struct {
+    z     *ZipStreamReader
+    zip64 bool
+    crc   hash.Hash32
+    n     uint64
+    done  bool
+}
+
+var descriptorSig = []byte("PK\x07\x08")
+
+func (s *storedScanner) Read(p []byte) (int, error) {
+    if s.done {
+        return 0, io.EOF
+    }
+    if len(p) == 0 {
+        return 0, nil
+    }
+    window, err := s.z.br.Peek(s.z.br.Size())
+    var n int
+    switch idx := bytes.Index(window, descriptorSig); {
+    case idx == 0:
+        if s.isDescriptor(window) {
+            s.done = true
+            return 0, io.EOF
+        }
+        n = 1
+    case idx > 0:
+        n = idx
+    default:
+        n = len(window) - (len(descriptorSig) - 1)
+        if n <= 0 {
+            if err == nil || err == io.EOF {
+                err = io.ErrUnexpectedEOF
+            }
+            return 0, err
+        }
+    }
+    if n > len(p) {
+        n = len(p)
+    }
+    copy(p, window[:n])
+    s.z.br.Discard(n)
+    s.z.off += int64(n)
+    s.crc.Write(p[:n])
+    s.n += uint64(n)
+    return n, nil
+}
+
+func (s *storedScanner) isDescriptor(b []byte) bool {
+    if len(b) < 16 || binary.LittleEndian.Uint32(b[4:]) != s.crc.Sum32() {
+        return false
+    }
+    if !s.zip64 && s.n <= 0xffffffff {
+        return uint64(binary.LittleEndian.Uint32(b[8:])) == s.n &&
+            uint64(binary.LittleEndian.Uint32(b[12:])) == s.n
+    }
+    return len(b) >= 24 && binary.LittleEndian.Uint64(b[8:]) == s.n &&
+        binary.LittleEndian.Uint64(b[16:]) == s.n
+}
+
+// entryReader decompresses the current entry and verifies it at EOF.
+type entryReader struct {
+    z     *ZipStreamReader
+    fh    *zip.FileHeader
+    zip64 bool
+    rc    io.ReadCloser
+    crc   hash.Hash32
+    n     uint64
+    start int64 // stream offset of the compressed data
+    err   error
+}
+
+func (e *entryReader) Read(p []byte) (int, error) {
+    if e.err != nil {
+        return 0, e.err
+    }
+    n, err := e.rc.Read(p)
+    e.crc.Write(p[:n])
+    e.n += uint64(n)
+    if err == io.EOF {
+        err = e.finish()
+        if err == nil {
+            err = io.EOF
+        }
+    }
+    if err != nil {
+        e.err = err
+        if err != io.EOF {
+            e.z.err = err
+        }
+    }
+    return n, err
+}
+
+// finish reads the data descriptor, if any, and checks the entry.
+func (e *entryReader) finish() error {
+    e.rc.Close()
+    fh := e.fh
+    csize := uint64(e.z.off - e.start)
+    if fh.Flags&zipFlagDescriptor != 0 {
+        if err := e.readDescriptor(csize); err != nil {
+            return err
+        }
+    }
+    if e.n != fh.UncompressedSize64 || csize != fh.CompressedSize64 || e.crc.Sum32() != fh.CRC32 {
+        return &EntryError{Name: fh.Name, Err: zip.ErrChecksum}
+    }
+    s := &e.z.seen[len(e.z.seen)-1]
+    s.crc, s.csize, s.usize = fh.CRC32, fh.CompressedSize64, fh.UncompressedSize64
+    return nil
+}
+
+func (e *entryReader) readDescriptor(csize uint64) error {
+    var buf [24]byte
+    if err := e.z.readFull(buf[:4]); err != nil {
+        return err
+    }
+    b := buf[:]
+    if binary.LittleEndian.Uint32(b) == zipDescriptorSig {
+        if err := e.z.readFull(b[:4]); err != nil {
+            return err
+        }
+    }
+    e.fh.CRC32 = binary.LittleEndian.Uint32(b)
+    if e.zip64 || csize > 0xffffffff || e.n > 0xffffffff {
+        if err := e.z.readFull(b[:16]); err != nil {
+            return err
+        }
+        e.fh.CompressedSize64 = binary.LittleEndian.Uint64(b)
+        e.fh.UncompressedSize64 = binary.LittleEndian.Uint64(b[8:])
+    } else {
+        if err := e.z.readFull(b[:8]); err != nil {
+            return err
+        }
+        e.fh.CompressedSize64 = uint64(binary.LittleEndian.Uint32(b))
+        e.fh.UncompressedSize64 = uint64(binary.LittleEndian.Uint32(b[4:]))
+    }
+    e.fh.CompressedSize = uint32(min64(e.fh.CompressedSize64, 0xffffffff))
+    e.fh.UncompressedSize = uint32(min64(e.fh.UncompressedSize64, 0xffffffff))
+    return nil
+}
+
+// readCentral reads the central directory, whose first signature has
+// already been consumed, and the end records that follow it.
+func (z *ZipStreamReader) readCentral() error {
+    cdStart := z.off - 4
+    for {
+        fh, offset, err := z.readCentralHeader()
+        if err != nil {
+            return err
+        }
+        i := len(z.central)
+        z.central = append(z.central, fh)
+        if i >= len(z.seen) {
+            return fmt.Errorf("%w: %s has no local header", ErrCentralMismatch, fh.Name)
+        }
+        s := z.seen[i]
+        if s.name != fh.Name || s.offset != offset || s.crc != fh.CRC32 ||
+            s.csize != fh.CompressedSize64 || s.usize != fh.UncompressedSize64 {
+            return fmt.Errorf("%w: %s", ErrCentralMismatch, fh.Name)
+        }
+
+        var sig [4]byte
+        if err := z.readFull(sig[:]); err != nil {
+            return err
+        }
+        switch binary.LittleEndian.Uint32(sig[:]) {
+        case zipCentralSig:
+            continue
+        case zip64EndSig:
+            if err := z.skipZip64End(); err != nil {
+                return err
+            }
+            return z.readEnd(cdStart)
+        case zipEndSig:
+            return z.readEnd(cdStart)
+        }
+        return zip.ErrFormat
+    }
+}
+
+func (z *ZipStreamReader) readCentralHeader() (*zip.FileHeader, int64, error) {
+    var buf [zipCentralLen - 4]byte
+    if err := z.readFull(buf[:]); err != nil {
+        return nil, 0, err
+    }
+    fh, nameLen, extraLen, commentLen, offset := decodeCentral(buf[:])
+    d := make([]byte, nameLen+extraLen+commentLen)
+    if err := z.readFull(d); err != nil {
+        return nil, 0, err
+    }
+    offset = finishCentral(fh, d, nameLen, extraLen, offset)
+    if z.charset != CharsetNone {
+        decodeZipName(fh, z.charset)
+    }
+    return fh, offset, nil
+}
+
+// decodeLocal decodes the fixed part of a local file header that follows
+// its signature. It returns the lengths of the name and extra field.
+func decodeLocal(buf []byte) (fh *zip.FileHeader, nameLen, extraLen int) {
+    b := readBuf(buf)
+    fh = &zip.FileHeader{}
+    fh.ReaderVersion = b.uint16()
+    fh.Flags = b.uint16()
+    fh.Method = b.uint16()
+    fh.ModifiedTime = b.uint16()
+    fh.ModifiedDate = b.uint16()
+    fh.CRC32 = b.uint32()
+    fh.CompressedSize64 = uint64(b.uint32())
+    fh.UncompressedSize64 = uint64(b.uint32())
+    return fh, int(b.uint16()), int(b.uint16())
+}
+
+// finishLocal fills in the name and extra field of a local header from d
+// and applies zip64 sizes. It reports whether a zip64 field was present.
+func finishLocal(fh *zip.FileHeader, d []byte, nameLen int) bool {
+    fh.Name = string(d[:nameLen])
+    fh.Extra = d[nameLen:]
+    fh.NonUTF8 = fh.Flags&0x800 == 0 && !isASCII(fh.Name)
+    zip64 := false
+    forEachExtra(fh.Extra, func(id uint16, field []byte) {
+        if id != zip64ExtraID {
+            return
+        }
+        zip64 = true
+        f := readBuf(field)
+        if fh.UncompressedSize64 == 0xffffffff && len(f) >= 8 {
+            fh.UncompressedSize64 = f.uint64()
+        }
+        if fh.CompressedSize64 == 0xffffffff && len(f) >= 8 {
+            fh.CompressedSize64 = f.uint64()
+        }
+    })
+    fh.Modified = zipModified(fh)
+    fh.CompressedSize = uint32(min64(fh.CompressedSize64, 0xffffffff))
+    fh.UncompressedSize = uint32(min64(fh.UncompressedSize64, 0xffffffff))
+    return zip64
+}
+
+// decodeCentral decodes the fixed part of a central directory header that
+// follows its signature.
+func decodeCentral(buf []byte) (fh *zip.FileHeader, nameLen, extraLen, commentLen int, offset int64) {
+    b := readBuf(buf)
+    fh = &zip.FileHeader{}
+    fh.CreatorVersion = b.uint16()
+    fh.ReaderVersion = b.uint16()
+    fh.Flags = b.uint16()
+    fh.Method = b.uint16()
+    fh.ModifiedTime = b.uint16()
+    fh.ModifiedDate = b.uint16()
+    fh.CRC32 = b.uint32()
+    fh.CompressedSize64 = uint64(b.uint32())
+    fh.UncompressedSize64 = uint64(b.uint32())
+    nameLen, extraLen, commentLen = int(b.uint16()), int(b.uint16()), int(b.uint16())
+    b = b[4:] // disk number start, internal attributes
+    fh.ExternalAttrs = b.uint32()
+    offset = int64(b.uint32())
+    return fh, nameLen, extraLen, commentLen, offset
+}
+
+// finishCentral fills in the variable fields of a central header from d
+// and applies zip64 values. It returns the local header offset.
+func finishCentral(fh *zip.FileHeader, d []byte, nameLen, extraLen int, offset int64) int64 {
+    fh.Name = string(d[:nameLen])
+    fh.Extra = d[nameLen : nameLen+extraLen]
+    fh.Comment = string(d[nameLen+extraLen:])
+    fh.NonUTF8 = fh.Flags&0x800 == 0 && !isASCII(fh.Name)
+    forEachExtra(fh.Extra, func(id uint16, field []byte) {
+        if id != zip64ExtraID {
+            return
+        }
+        f := readBuf(field)
+        if fh.UncompressedSize64 == 0xffffffff && len(f) >= 8 {
+            fh.UncompressedSize64 = f.uint64()
+        }
+        if fh.CompressedSize64 == 0xffffffff && len(f) >= 8 {
+            fh.CompressedSize64 = f.uint64()
+        }
+        if offset == 0xffffffff && len(f) >= 8 {
+            offset = int64(f.uint64())
+        }
+    })
+    fh.Modified = zipModified(fh)
+    fh.CompressedSize = uint32(min64(fh.CompressedSize64, 0xffffffff))
+    fh.UncompressedSize = uint32(min64(fh.UncompressedSize64, 0xffffffff))
+    return offset
+}
+
+// skipZip64End skips the zip64 end record and its locator.
+func (z *ZipStreamReader) skipZip64End() error {
+    var buf [20]byte
+    if err := z.readFull(buf[:8]); err != nil {
+        return err
+    }
+    size := int64(binary.LittleEndian.Uint64(buf[:8]))
+    n, err := io.CopyN(ioutil.Discard, z.br, size)
+    z.off += n
+    if err != nil {
+        return err
+    }
+    if err := z.readFull(buf[:4]); err != nil {
+        return err
+    }
+    if binary.LittleEndian.Uint32(buf[:4]) != zip64LocatorSig {
+        return zip.ErrFormat
+    }
+    if err := z.readFull(buf[:16]); err != nil {
+        return err
+    }
+    var sig [4]byte
+    if err := z.readFull(sig[:]); err != nil {
+        return err
+    }
+    if binary.LittleEndian.Uint32(sig[:]) != zipEndSig {
+        return zip.ErrFormat
+    }
+    return nil
+}
+
+// readEnd reads the end of central directory record, whose signature has
+// already been consumed.
+func (z *ZipStreamReader) readEnd(cdStart int64) error {
+    var buf [zipEndLen - 4]byte
+    if err := z.readFull(buf[:]); err != nil {
+        return err
+    }
+    b := readBuf(buf[:])
+    b = b[6:] // disk numbers, entries on this disk
+    count := b.uint16()
+    b = b[4:] // directory size
+    offset := b.uint32()
+    commentLen := int(b.uint16())
+    comment := make([]byte, commentLen)
+    if err := z.readFull(comment); err != nil {
+        return err
+    }
+    z.comment = string(comment)
+    if count != 0xffff && int(count) != len(z.central)&0xffff {
+        return fmt.Errorf("%w: entry count", ErrCentralMismatch)
+    }
+    if len(z.central) != len(z.seen) {
+        return fmt.Errorf("%w: %d local headers, %d central headers", ErrCentralMismatch, len(z.seen), len(z.central))
+    }
+    if offset != 0xffffffff && int64(offset) != cdStart && len(z.central) > 0 {
+        return fmt.Errorf("%w: directory offset", ErrCentralMismatch)
+    }
+    return nil
+}
+
+// ZipStreamWriter writes a zip archive to a plain io.Writer, such as a
+// socket or pipe. Content sizes need not be known in advance: each entry
+// is followed by a data descriptor carrying its CRC-32 and sizes, switching
+// to the zip64 form when an entry grows past 4 GiB.
+type ZipStreamWriter struct {
+    zw *zip.Writer
+}
+
+// NewZipStreamWriter returns a writer producing a zip archive on w.
+func NewZipStreamWriter(w io.Writer) *ZipStreamWriter {
+    return &ZipStreamWriter{zw: newZipWriter(w)}
+}
+
+// RegisterCompressor registers a compressor for a compression method.
+func (w *ZipStreamWriter) RegisterCompressor(method uint16, comp zip.Compressor) {
+    w.zw.RegisterCompressor(method, comp)
+}
+
+// Create adds a deflated entry of unknown size.
+func (w *ZipStreamWriter) Create(name string) (io.Writer, error) {
+    return w.CreateHeader(&zip.FileHeader{Name: name, Method: zip.Deflate, Modified: time.Now()})
+}
+
+// CreateHeader adds an entry described by fh. Any sizes and CRC-32 set in
+// fh are ignored; they are computed while the content is written.
+func (w *ZipStreamWriter) CreateHeader(fh *zip.FileHeader) (io.Writer, error) {
+    if fh.Method == LZMA {
+        fh.Flags |= zipFlagLZMAEOS
+    }
+    fh.CRC32 = 0
+    fh.CompressedSize, fh.CompressedSize64 = 0, 0
+    fh.UncompressedSize, fh.UncompressedSize64 = 0, 0
+    setUTF8Flag(fh)
+    setZipModified(fh, fh.Modified)
+    return w.zw.CreateHeader(fh)
+}
+
+// SetComment sets the archive comment.
+func (w *ZipStreamWriter) SetComment(comment string) error { return w.zw.SetComment(comment) }
+
+// Flush flushes buffered data to the underlying writer.
+func (w *ZipStreamWriter) Flush() error { return w.zw.Flush() }
+
+// Close writes the central directory. It does not close the underlying writer.
+func (w *ZipStreamWriter) Close() error { return w.zw.Close() }
+
+// ZipStreamReadWrite pipes an archive from a writer goroutine to a reader
+// without ever seeking, the way it would flow over a socket.
+func ZipStreamReadWrite() {
+    pr, pw := io.Pipe()
+    go func() {
+        w := NewZipStreamWriter(pw)
+        var files = []struct {
+            Name, Body string
+        }{
+            {"readme.txt", "This archive contains some text files."},
+            {"gopher.txt", "Gopher names:\nGeorge\nGeoffrey\nGonzo"},
+            {"todo.txt", "Get animal handling licence.\nWrite more examples."},
+        }
+        for _, file := range files {
+            f, err := w.Create(file.Name)
+            if err != nil {
+                pw.CloseWithError(err)
+                return
+            }
+            if _, err := f.Write([]byte(file.Body)); err != nil {
+                pw.CloseWithError(err)
+                return
+            }
+        }
+        pw.CloseWithError(w.Close())
+    }()
+
+    r := NewZipStreamReader(pr)
+    for {
+        fh, err := r.Next()
+        if err == io.EOF {
+            break
+        }
+        if err != nil {
+            fmt.Println("stream err", err)
+            return
+        }
+        fmt.Printf("Contents of %s:\n", fh.Name)
+        var buf bytes.Buffer
+        if _, err := io.Copy(&buf, r); err != nil {
+            fmt.Println("read err", err)
+            return
+        }
+        fmt.Println(buf.String())
+    }
+}
+
+// readBuf decodes little-endian fields, as in archive/zip.
+type readBuf []byte
+
+func (b *readBuf) uint16() uint16 {
+    v := binary.LittleEndian.Uint16(*b)
+    *b = (*b)[2:]
+    return v
+}
+
+func (b *readBuf) uint32() uint32 {
+    v := binary.LittleEndian.Uint32(*b)
+    *b = (*b)[4:]
+    return v
+}
+
+func (b *readBuf) uint64() uint64 {
+    v := binary.LittleEndian.Uint64(*b)
+    *b = (*b)[8:]
+    return v
+}
+
+// forEachExtra calls fn for each well-formed field of a zip extra block.
+func forEachExtra(extra []byte, fn func(id uint16, field []byte)) {
+    for len(extra) >= 4 {
+        id := binary.LittleEndian.Uint16(extra)
+        size := int(binary.LittleEndian.Uint16(extra[2:]))
+        if 4+size > len(extra) {
+            return
+        }
+        fn(id, extra[4:4+size])
+        extra = extra[4+size:]
+    }
+}
+
+func isASCII(s string) bool {
+    for i := 0; i < len(s); i++ {
+        if s[i] >= 0x80 {
+            return false
+        }
+    }
+    return true
+}
+
+func min64(a, b uint64) uint64 {
+    if a < b {
+        return a
+    }
+    return b
+}
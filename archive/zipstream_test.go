@@ -0,0 +1,114 @@
+package archive
+
+import (
+    "archive/zip"
+    "bytes"
+    "errors"
+    "io"
+    "io/ioutil"
+    "strings"
+    "testing"
+    "testing/iotest"
+)
+
+var streamFiles = []struct {
+    Name   string
+    Method uint16
+    Body   string
+}{
+    {"readme.txt", zip.Deflate, "This archive contains some text files."},
+    {"stored.bin", zip.Store, "looks like a descriptor: PK\x07\x08 but is not"},
+    {"empty.txt", zip.Store, ""},
+    {"big.txt", zip.Deflate, strings.Repeat("Gopher names:\nGeorge\nGeoffrey\nGonzo\n", 5000)},
+}
+
+func buildStream(t *testing.T) []byte {
+    var buf bytes.Buffer
+    w := NewZipStreamWriter(&buf)
+    for _, f := range streamFiles {
+        fw, err := w.CreateHeader(&zip.FileHeader{Name: f.Name, Method: f.Method})
+        if err != nil {
+            t.Fatal(err)
+        }
+        if _, err := io.WriteString(fw, f.Body); err != nil {
+            t.Fatal(err)
+        }
+    }
+    if err := w.Close(); err != nil {
+        t.Fatal(err)
+    }
+    return buf.Bytes()
+}
+
+func readStream(r io.Reader) (map[string]string, *ZipStreamReader, error) {
+    zr := NewZipStreamReader(r)
+    got := map[string]string{}
+    for {
+        fh, err := zr.Next()
+        if err == io.EOF {
+            return got, zr, nil
+        }
+        if err != nil {
+            return got, zr, err
+        }
+        b, err := ioutil.ReadAll(zr)
+        if err != nil {
+            return got, zr, err
+        }
+        got[fh.Name] = string(b)
+    }
+}
+
+func TestZipStreamRoundTrip(t *testing.T) {
+    data := buildStream(t)
+    got, zr, err := readStream(iotest.HalfReader(bytes.NewReader(data)))
+    if err != nil {
+        t.Fatal(err)
+    }
+    for _, f := range streamFiles {
+        if got[f.Name] != f.Body {
+            t.Errorf("%s: got %d bytes, want %d", f.Name, len(got[f.Name]), len(f.Body))
+        }
+    }
+    if len(zr.Central()) != len(streamFiles) {
+        t.Fatalf("central directory has %d entries", len(zr.Central()))
+    }
+}
+
+func TestZipStreamKnownSizes(t *testing.T) {
+    data, err := ioutil.ReadFile("testdata/readme.zip")
+    if err != nil {
+        t.Fatal(err)
+    }
+    got, _, err := readStream(bytes.NewReader(data))
+    if err != nil {
+        t.Fatal(err)
+    }
+    if len(got) != 3 || !strings.HasPrefix(got["readme.txt"], "This archive") {
+        t.Fatalf("got %v", got)
+    }
+}
+
+func TestZipStreamCentralMismatch(t *testing.T) {
+    data := buildStream(t)
+    // Rename the first entry in the central directory only.
+    i := bytes.LastIndex(data, []byte("readme.txt"))
+    data[i] = 'R'
+    if _, _, err := readStream(bytes.NewReader(data)); !errors.Is(err, ErrCentralMismatch) {
+        t.Fatalf("err = %v, want ErrCentralMismatch", err)
+    }
+}
+
+func TestZipStreamChecksum(t *testing.T) {
+    data := buildStream(t)
+    // Corrupt the CRC-32 in the first data descriptor.
+    i := bytes.Index(data, []byte("PK\x07\x08"))
+    data[i+4] ^= 0xff
+    if _, _, err := readStream(bytes.NewReader(data)); !errors.Is(err, zip.ErrChecksum) {
+        t.Fatalf("err = %v, want zip.ErrChecksum", err)
+    }
+}
+
+func TestZipStreamReadWrite(t *testing.T) {
+    ZipStreamReadWrite()
+}
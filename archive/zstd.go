@@ -0,0 +1,1320 @@
+package archive
+
+import (
+    "bufio"
+    "bytes"
+    "encoding/binary"
+    "errors"
+    "io"
+    "io/ioutil"
+    "math/bits"
+)
+
+// Zstandard (RFC 8878) support for zip method 93. The reader handles the
+// whole format except dictionaries; the writer emits Huffman or raw
+// literals and sequences coded with the predefined FSE tables.
+
+var (
+    errZstd           = errors.New("archive: corrupt zstd data")
+    errZstdDictionary = errors.New("archive: zstd dictionaries are not supported")
+)
+
+const (
+    zstdMagic        = 0xfd2fb528
+    zstdMaxBlock     = 128 << 10
+    zstdMaxWindow    = 1 << 30
+    zstdWriterWindow = 20 // log2 of the writer's window
+)
+
+var (
+    zstdLLBase = [36]uint32{
+        0, 1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11, 12, 13, 14, 15,
+        16, 18, 20, 22, 24, 28, 32, 40, 48, 64, 128, 256, 512, 1024, 2048, 4096,
+        8192, 16384, 32768, 65536}
+    zstdLLBits = [36]uint8{
+        0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+        1, 1, 1, 1, 2, 2, 3, 3, 4, 6, 7, 8, 9, 10, 11, 12,
+        13, 14, 15, 16}
+    zstdMLBase = [53]uint32{
+        3, 4, 5, 6, 7, 8, 9, 10, 11, 12, 13, 14, 15, 16, 17, 18,
+        19, 20, 21, 22, 23, 24, 25, 26, 27, 28, 29, 30, 31, 32, 33, 34,
+        35, 37, 39, 41, 43, 47, 51, 59, 67, 83, 99, 131, 259, 515, 1027, 2051,
+        4099, 8195, 16387, 32771, 65539}
+    zstdMLBits = [53]uint8{
+        0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+        0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+        1, 1, 1, 1, 2, 2, 3, 3, 4, 4, 5, 7, 8, 9, 10, 11,
+        12, 13, 14, 15, 16}
+
+    zstdLLDefault = []int16{
+        4, 3, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 1, 1, 1,
+        2, 2, 2, 2, 2, 2, 2, 2, 2, 3, 2, 1, 1, 1, 1, 1,
+        -1, -1, -1, -1}
+    zstdMLDefault = []int16{
+        1, 4, 3, 2, 2, 2, 2, 2, 2, 1, 1, 1, 1, 1, 1, 1,
+        1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+        1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, -1, -1,
+        -1, -1, -1, -1, -1}
+    zstdOFDefault = []int16{
+        1, 1, 1, 1, 1, 1, 2, 2, 2, 1, 1, 1, 1, 1, 1, 1,
+        1, 1, 1, 1, 1, 1, 1, 1, -1, -1, -1, -1, -1}
+
+    zstdLLTable = newFSETable(zstdLLDefault, 6)
+    zstdMLTable = newFSETable(zstdMLDefault, 6)
+    zstdOFTable = newFSETable(zstdOFDefault, 5)
+)
+
+// zstdBits reads a zstd backward bit stream: bits are consumed from the
+// end of the stream, below the marker bit in its last byte. Reading past
+// the start yields zeros and leaves pos negative.
+type zstdBits struct {
+    b   []byte
+    pos int // bits left to read
+}
+
+func newZstdBits(b []byte) (*zstdBits, error) {
+    if len(b) == 0 || b[len(b)-1] == 0 {
+        return nil, errZstd
+    }
+    return &zstdBits{b: b, pos: (len(b)-1)*8 + bits.Len8(b[len(b)-1]) - 1}, nil
+}
+
+// peek returns the next n bits, n at most 56, without consuming them.
+func (br *zstdBits) peek(n uint) uint64 {
+    if n == 0 {
+        return 0
+    }
+    lo := br.pos - int(n)
+    if br.pos <= 0 {
+        return 0
+    }
+    start := lo
+    if start < 0 {
+        start = 0
+    }
+    var x uint64
+    for i, k := start>>3, uint(0); k < 64 && i < len(br.b); i, k = i+1, k+8 {
+        x |= uint64(br.b[i]) << k
+    }
+    x >>= uint(start & 7)
+    x &= 1<<uint(br.pos-start) - 1
+    if lo < 0 {
+        x <<= uint(-lo)
+    }
+    return x & (1<<n - 1)
+}
+
+func (br *zstdBits) read(n uint) uint64 {
+    v := br.peek(n)
+    br.pos -= int(n)
+    return v
+}
+
+// fseTable is an FSE decoding table; encoding tables are derived from
+// the same normalized distribution.
+type fseTable struct {
+    log     uint
+    entries []fseEntry
+    norm    []int16
+}
+
+type fseEntry struct {
+    sym   uint8
+    nbits uint8
+    base  uint16
+}
+
+func newFSETable(norm []int16, log uint) *fseTable {
+    size := 1 << log
+    t := &fseTable{log: log, entries: make([]fseEntry, size), norm: norm}
+    syms := fseSpread(norm, log)
+    next := make([]int, len(norm))
+    for s, n := range norm {
+        if n == -1 {
+            next[s] = 1
+        } else {
+            next[s] = int(n)
+        }
+    }
+    for u, s := range syms {
+        n := next[s]
+        next[s]++
+        nb := log - uint(bits.Len(uint(n))-1)
+        t.entries[u] = fseEntry{sym: s, nbits: uint8(nb), base: uint16(n<<nb - size)}
+    }
+    return t
+}
+
+// fseSpread returns the symbol of each state.
+func fseSpread(norm []int16, log uint) []uint8 {
+    size := 1 << log
+    syms := make([]uint8, size)
+    high := size - 1
+    for s, n := range norm {
+        if n == -1 {
+            syms[high] = uint8(s)
+            high--
+        }
+    }
+    step := size>>1 + size>>3 + 3
+    pos := 0
+    for s, n := range norm {
+        for i := 0; i < int(n); i++ {
+            syms[pos] = uint8(s)
+            pos = (pos + step) & (size - 1)
+            for pos > high {
+                pos = (pos + step) & (size - 1)
+            }
+        }
+    }
+    return syms
+}
+
+// readFSETable parses an FSE table description and returns the table and
+// the number of bytes it used.
+func readFSETable(b []byte, maxSym int, maxLog uint) (*fseTable, int, error) {
+    pos := 0 // in bits
+    readBits := func(n uint) int {
+        v := 0
+        for i := uint(0); i < n; i++ {
+            p := pos + int(i)
+            if p>>3 < len(b) {
+                v |= int(b[p>>3]>>uint(p&7)&1) << i
+            }
+        }
+        return v
+    }
+    if len(b) == 0 {
+        return nil, 0, errZstd
+    }
+    log := uint(readBits(4)) + 5
+    pos += 4
+    if log > maxLog {
+        return nil, 0, errZstd
+    }
+    remaining := 1<<log + 1
+    threshold := 1 << log
+    nbits := log + 1
+    var norm []int16
+    for remaining > 1 && len(norm) <= maxSym {
+        max := 2*threshold - 1 - remaining
+        var count int
+        if v := readBits(nbits - 1); v < max {
+            count = v
+            pos += int(nbits) - 1
+        } else {
+            count = readBits(nbits)
+            if count >= threshold {
+                count -= max
+            }
+            pos += int(nbits)
+        }
+        count--
+        if count < 0 {
+            remaining--
+        } else {
+            remaining -= count
+        }
+        norm = append(norm, int16(count))
+        if count == 0 {
+            for {
+                rep := readBits(2)
+                pos += 2
+                for i := 0; i < rep; i++ {
+                    norm = append(norm, 0)
+                }
+                if rep != 3 {
+                    break
+                }
+            }
+        }
+        for remaining < threshold && nbits > 1 {
+            nbits--
+            threshold >>= 1
+        }
+        if pos > len(b)*8 {
+            return nil, 0, errZstd
+        }
+    }
+    if remaining != 1 || len(norm) > maxSym+1 {
+        return nil, 0, errZstd
+    }
+    return newFSETable(norm, log), (pos + 7) / 8, nil
+}
+
+// fseState walks an FSE decoding table.
+type fseState struct {
+    t     *fseTable
+    state int
+}
+
+func (s *fseState) init(t *fseTable, br *zstdBits) {
+    s.t = t
+    s.state = int(br.read(t.log))
+}
+
+func (s *fseState) symbol() uint8 { return s.t.entries[s.state].sym }
+
+func (s *fseState) update(br *zstdBits) {
+    e := s.t.entries[s.state]
+    s.state = int(e.base) + int(br.read(uint(e.nbits)))
+}
+
+// huffTable decodes zstd Huffman coded literals.
+type huffTable struct {
+    maxBits uint
+    entries []huffEntry // indexed by the next maxBits bits
+}
+
+type huffEntry struct {
+    sym   uint8
+    nbits uint8
+}
+
+// readHuffTable parses a Huffman tree description and returns the table
+// and the number of bytes it used.
+func readHuffTable(b []byte) (*huffTable, int, error) {
+    if len(b) == 0 {
+        return nil, 0, errZstd
+    }
+    var weights []uint8
+    used := 0
+    if hb := int(b[0]); hb >= 128 {
+        n := hb - 127
+        used = 1 + (n+1)/2
+        if used > len(b) {
+            return nil, 0, errZstd
+        }
+        for i := 0; i < n; i++ {
+            w := b[1+i/2]
+            if i%2 == 0 {
+                w >>= 4
+            }
+            weights = append(weights, w&15)
+        }
+    } else {
+        used = 1 + hb
+        if used > len(b) {
+            return nil, 0, errZstd
+        }
+        t, n, err := readFSETable(b[1:used], 255, 6)
+        if err != nil {
+            return nil, 0, err
+        }
+        br, err := newZstdBits(b[1+n : used])
+        if err != nil {
+            return nil, 0, err
+        }
+        // Two interleaved states, until the stream is exhausted.
+        var s1, s2 fseState
+        s1.init(t, br)
+        s2.init(t, br)
+        for len(weights) < 255 {
+            weights = append(weights, s1.symbol())
+            s1.update(br)
+            if br.pos < 0 {
+                weights = append(weights, s2.symbol())
+                break
+            }
+            weights = append(weights, s2.symbol())
+            s2.update(br)
+            if br.pos < 0 {
+                weights = append(weights, s1.symbol())
+                break
+            }
+        }
+    }
+    // The weight of the last symbol completes the sum to a power of two.
+    total := 0
+    for _, w := range weights {
+        if w > 11 {
+            return nil, 0, errZstd
+        }
+        if w > 0 {
+            total += 1 << (w - 1)
+        }
+    }
+    if total == 0 || len(weights) > 255 {
+        return nil, 0, errZstd
+    }
+    maxBits := uint(bits.Len(uint(total)))
+    rest := 1<<maxBits - total
+    if rest&(rest-1) != 0 || maxBits > 11 {
+        return nil, 0, errZstd
+    }
+    weights = append(weights, uint8(bits.Len(uint(rest))))
+    h := &huffTable{maxBits: maxBits, entries: make([]huffEntry, 1<<maxBits)}
+    pos := 0
+    for w := uint8(1); w <= 12; w++ {
+        for s, sw := range weights {
+            if sw != w {
+                continue
+            }
+            n := 1 << (w - 1)
+            e := huffEntry{sym: uint8(s), nbits: uint8(maxBits + 1 - uint(w))}
+            for i := 0; i < n; i++ {
+                h.entries[pos+i] = e
+            }
+            pos += n
+        }
+    }
+    return h, used, nil
+}
+
+// decode decodes n literals from one stream into out.
+func (h *huffTable) decode(out, stream []byte, n int) ([]byte, error) {
+    br, err := newZstdBits(stream)
+    if err != nil {
+        return nil, err
+    }
+    for i := 0; i < n; i++ {
+        e := h.entries[br.peek(h.maxBits)]
+        out = append(out, e.sym)
+        br.pos -= int(e.nbits)
+    }
+    if br.pos != 0 {
+        return nil, errZstd
+    }
+    return out, nil
+}
+
+// zstdReader decompresses a single zstd frame. Skippable frames before it
+// are ignored.
+type zstdReader struct {
+    r        io.Reader
+    br       io.ByteReader
+    window   int
+    checksum bool
+    xxh      *xxh64
+    hist     []byte // decoded output; the last window bytes are referenced
+    rpos     int
+    begun    bool
+    last     bool
+    err      error
+    block    []byte
+    lits     []byte
+
+    huff                *huffTable
+    llTab, ofTab, mlTab *fseTable
+    reps                [3]int
+}
+
+// newZstdReader returns a reader decompressing the zstd frame in r. No
+// input past the end of the frame is consumed if r is an io.ByteReader.
+func newZstdReader(r io.Reader) io.ReadCloser {
+    br, ok := r.(io.ByteReader)
+    if !ok {
+        b := bufio.NewReader(r)
+        br, r = b, b
+    }
+    return &zstdReader{r: r, br: br, reps: [3]int{1, 4, 8}}
+}
+
+func (z *zstdReader) Close() error { return nil }
+
+func (z *zstdReader) Read(p []byte) (int, error) {
+    for z.rpos == len(z.hist) {
+        if z.err != nil {
+            return 0, z.err
+        }
+        if len(z.hist) > 2*z.window+zstdMaxBlock && len(z.hist) > 1<<20 {
+            n := copy(z.hist, z.hist[len(z.hist)-z.window:])
+            z.hist = z.hist[:n]
+            z.rpos = n
+        }
+        z.err = z.next()
+    }
+    n := copy(p, z.hist[z.rpos:])
+    z.rpos += n
+    return n, nil
+}
+
+func (z *zstdReader) readFull(n int) ([]byte, error) {
+    if cap(z.block) < n {
+        z.block = make([]byte, n)
+    }
+    b := z.block[:n]
+    _, err := io.ReadFull(z.r, b)
+    return b, unexpected(err)
+}
+
+// next decodes the frame header or the next block.
+func (z *zstdReader) next() error {
+    if !z.begun {
+        z.begun = true
+        return z.frameHeader()
+    }
+    if z.last {
+        if z.checksum {
+            b, err := z.readFull(4)
+            if err != nil {
+                return err
+            }
+            if binary.LittleEndian.Uint32(b) != uint32(z.xxh.sum64()) {
+                return errZstd
+            }
+        }
+        return io.EOF
+    }
+    hdr, err := z.readFull(3)
+    if err != nil {
+        return err
+    }
+    h := int(hdr[0]) | int(hdr[1])<<8 | int(hdr[2])<<16
+    z.last = h&1 != 0
+    size := h >> 3
+    max := zstdMaxBlock
+    if z.window < max {
+        max = z.window
+    }
+    if size > max {
+        return errZstd
+    }
+    start := len(z.hist)
+    switch h >> 1 & 3 {
+    case 0:
+        b, err := z.readFull(size)
+        if err != nil {
+            return err
+        }
+        z.hist = append(z.hist, b...)
+    case 1:
+        c, err := z.br.ReadByte()
+        if err != nil {
+            return unexpected(err)
+        }
+        for i := 0; i < size; i++ {
+            z.hist = append(z.hist, c)
+        }
+    case 2:
+        b, err := z.readFull(size)
+        if err != nil {
+            return err
+        }
+        if err := z.compressed(b); err != nil {
+            return err
+        }
+    default:
+        return errZstd
+    }
+    if len(z.hist)-start > max {
+        return errZstd
+    }
+    if z.checksum {
+        z.xxh.write(z.hist[start:])
+    }
+    return nil
+}
+
+func (z *zstdReader) frameHeader() error {
+    var magic [4]byte
+    for {
+        for i := range magic {
+            c, err := z.br.ReadByte()
+            if err != nil {
+                if i == 0 && err == io.EOF {
+                    return errZstd
+                }
+                return unexpected(err)
+            }
+            magic[i] = c
+        }
+        m := binary.LittleEndian.Uint32(magic[:])
+        if m == zstdMagic {
+            break
+        }
+        if m&0xfffffff0 != 0x184d2a50 {
+            return errZstd
+        }
+        b, err := z.readFull(4)
+        if err != nil {
+            return err
+        }
+        if _, err := io.CopyN(ioutil.Discard, z.r, int64(binary.LittleEndian.Uint32(b))); err != nil {
+            return unexpected(err)
+        }
+    }
+    fhd, err := z.br.ReadByte()
+    if err != nil {
+        return unexpected(err)
+    }
+    if fhd&0x08 != 0 {
+        return errZstd
+    }
+    single := fhd&0x20 != 0
+    z.checksum = fhd&0x04 != 0
+    if z.checksum {
+        z.xxh = newXXH64()
+    }
+    if !single {
+        wd, err := z.br.ReadByte()
+        if err != nil {
+            return unexpected(err)
+        }
+        log := uint(wd>>3) + 10
+        base := 1 << log
+        z.window = base + base/8*int(wd&7)
+    }
+    if n := [4]int{0, 1, 2, 4}[fhd&3]; n > 0 {
+        b, err := z.readFull(n)
+        if err != nil {
+            return err
+        }
+        for _, c := range b {
+            if c != 0 {
+                return errZstdDictionary
+            }
+        }
+    }
+    fcsLen := [4]int{0, 2, 4, 8}[fhd>>6]
+    if fcsLen == 0 && single {
+        fcsLen = 1
+    }
+    if fcsLen > 0 {
+        b, err := z.readFull(fcsLen)
+        if err != nil {
+            return err
+        }
+        var size uint64
+        for i := len(b) - 1; i >= 0; i-- {
+            size = size<<8 | uint64(b[i])
+        }
+        if fcsLen == 2 {
+            size += 256
+        }
+        if single {
+            if size > zstdMaxWindow {
+                return errZstd
+            }
+            z.window = int(size)
+        }
+    }
+    if z.window > zstdMaxWindow {
+        return errZstd
+    }
+    return nil
+}
+
+// compressed decodes a compressed block.
+func (z *zstdReader) compressed(b []byte) error {
+    n, err := z.literals(b)
+    if err != nil {
+        return err
+    }
+    return z.sequences(b[n:])
+}
+
+// literals decodes the literals section into z.lits and returns its size.
+func (z *zstdReader) literals(b []byte) (int, error) {
+    if len(b) == 0 {
+        return 0, errZstd
+    }
+    typ, sf := b[0]&3, b[0]>>2&3
+    z.lits = z.lits[:0]
+    if typ < 2 {
+        var regen, hl int
+        switch sf {
+        case 0, 2:
+            regen, hl = int(b[0]>>3), 1
+        case 1:
+            if len(b) < 2 {
+                return 0, errZstd
+            }
+            regen, hl = int(b[0]>>4)|int(b[1])<<4, 2
+        case 3:
+            if len(b) < 3 {
+                return 0, errZstd
+            }
+            regen, hl = int(b[0]>>4)|int(b[1])<<4|int(b[2])<<12, 3
+        }
+        if regen > zstdMaxBlock {
+            return 0, errZstd
+        }
+        if typ == 0 {
+            if hl+regen > len(b) {
+                return 0, errZstd
+            }
+            z.lits = append(z.lits, b[hl:hl+regen]...)
+            return hl + regen, nil
+        }
+        if hl >= len(b) {
+            return 0, errZstd
+        }
+        for i := 0; i < regen; i++ {
+            z.lits = append(z.lits, b[hl])
+        }
+        return hl + 1, nil
+    }
+    var hl int
+    var sizeBits uint
+    switch sf {
+    case 0, 1:
+        hl, sizeBits = 3, 10
+    case 2:
+        hl, sizeBits = 4, 14
+    case 3:
+        hl, sizeBits = 5, 18
+    }
+    if len(b) < hl {
+        return 0, errZstd
+    }
+    var hdr uint64
+    for i := hl - 1; i >= 0; i-- {
+        hdr = hdr<<8 | uint64(b[i])
+    }
+    regen := int(hdr >> 4 & (1<<sizeBits - 1))
+    comp := int(hdr >> (4 + sizeBits) & (1<<sizeBits - 1))
+    if regen > zstdMaxBlock || hl+comp > len(b) {
+        return 0, errZstd
+    }
+    data := b[hl : hl+comp]
+    if typ == 2 {
+        h, n, err := readHuffTable(data)
+        if err != nil {
+            return 0, err
+        }
+        z.huff = h
+        data = data[n:]
+    } else if z.huff == nil {
+        return 0, errZstd
+    }
+    var err error
+    if sf == 0 {
+        z.lits, err = z.huff.decode(z.lits, data, regen)
+    } else {
+        if len(data) < 6 {
+            return 0, errZstd
+        }
+        sizes := [4]int{
+            int(binary.LittleEndian.Uint16(data)),
+            int(binary.LittleEndian.Uint16(data[2:])),
+            int(binary.LittleEndian.Uint16(data[4:])),
+        }
+        data = data[6:]
+        sizes[3] = len(data) - sizes[0] - sizes[1] - sizes[2]
+        if sizes[3] < 0 {
+            return 0, errZstd
+        }
+        per := (regen + 3) / 4
+        for i, size := range sizes {
+            n := per
+            if i == 3 {
+                n = regen - 3*per
+            }
+            if n < 0 {
+                return 0, errZstd
+            }
+            if z.lits, err = z.huff.decode(z.lits, data[:size], n); err != nil {
+                return 0, err
+            }
+            data = data[size:]
+        }
+    }
+    if err != nil {
+        return 0, err
+    }
+    return hl + comp, nil
+}
+
+// sequences decodes and executes the sequences section.
+func (z *zstdReader) sequences(b []byte) error {
+    if len(b) == 0 {
+        return errZstd
+    }
+    nseq := int(b[0])
+    switch {
+    case nseq == 0:
+        z.hist = append(z.hist, z.lits...)
+        return nil
+    case nseq < 128:
+        b = b[1:]
+    case nseq < 255:
+        if len(b) < 2 {
+            return errZstd
+        }
+        nseq = (nseq-128)<<8 + int(b[1])
+        b = b[2:]
+    default:
+        if len(b) < 3 {
+            return errZstd
+        }
+        nseq = int(b[1]) + int(b[2])<<8 + 0x7f00
+        b = b[3:]
+    }
+    if len(b) == 0 {
+        return errZstd
+    }
+    modes := b[0]
+    if modes&3 != 0 {
+        return errZstd
+    }
+    b = b[1:]
+    var err error
+    if z.llTab, b, err = z.seqTable(b, modes>>6, z.llTab, zstdLLTable, 35, 9); err != nil {
+        return err
+    }
+    if z.ofTab, b, err = z.seqTable(b, modes>>4&3, z.ofTab, zstdOFTable, 31, 8); err != nil {
+        return err
+    }
+    if z.mlTab, b, err = z.seqTable(b, modes>>2&3, z.mlTab, zstdMLTable, 52, 9); err != nil {
+        return err
+    }
+    br, err := newZstdBits(b)
+    if err != nil {
+        return err
+    }
+    var ll, of, ml fseState
+    ll.init(z.llTab, br)
+    of.init(z.ofTab, br)
+    ml.init(z.mlTab, br)
+    lits := z.lits
+    for i := 0; i < nseq; i++ {
+        llc, ofc, mlc := ll.symbol(), of.symbol(), ml.symbol()
+        if llc > 35 || mlc > 52 || ofc > 31 {
+            return errZstd
+        }
+        offset := int(1<<ofc + br.read(uint(ofc)))
+        mlen := int(zstdMLBase[mlc] + uint32(br.read(uint(zstdMLBits[mlc]))))
+        llen := int(zstdLLBase[llc] + uint32(br.read(uint(zstdLLBits[llc]))))
+        if offset > 3 {
+            offset -= 3
+            z.reps[2], z.reps[1], z.reps[0] = z.reps[1], z.reps[0], offset
+        } else {
+            if llen == 0 {
+                offset++
+            }
+            switch offset {
+            case 1:
+                offset = z.reps[0]
+            case 2:
+                offset = z.reps[1]
+                z.reps[1], z.reps[0] = z.reps[0], offset
+            case 3:
+                offset = z.reps[2]
+                z.reps[2], z.reps[1], z.reps[0] = z.reps[1], z.reps[0], offset
+            default:
+                offset = z.reps[0] - 1
+                if offset == 0 {
+                    return errZstd
+                }
+                z.reps[2], z.reps[1], z.reps[0] = z.reps[1], z.reps[0], offset
+            }
+        }
+        if llen > len(lits) {
+            return errZstd
+        }
+        z.hist = append(z.hist, lits[:llen]...)
+        lits = lits[llen:]
+        if offset > len(z.hist) || offset > z.window {
+            return errZstd
+        }
+        for j := len(z.hist) - offset; mlen > 0; mlen-- {
+            z.hist = append(z.hist, z.hist[j])
+            j++
+        }
+        if i < nseq-1 {
+            ll.update(br)
+            ml.update(br)
+            of.update(br)
+        }
+        if br.pos < 0 {
+            return errZstd
+        }
+    }
+    if br.pos != 0 {
+        return errZstd
+    }
+    z.hist = append(z.hist, lits...)
+    return nil
+}
+
+// seqTable reads the table for one sequence symbol type according to its
+// compression mode.
+func (z *zstdReader) seqTable(b []byte, mode uint8, prev, predef *fseTable, maxSym int, maxLog uint) (*fseTable, []byte, error) {
+    switch mode {
+    case 0:
+        return predef, b, nil
+    case 1:
+        if len(b) == 0 || int(b[0]) > maxSym {
+            return nil, nil, errZstd
+        }
+        norm := make([]int16, int(b[0])+1)
+        norm[b[0]] = 1
+        return newFSETable(norm, 0), b[1:], nil
+    case 2:
+        t, n, err := readFSETable(b, maxSym, maxLog)
+        if err != nil {
+            return nil, nil, err
+        }
+        return t, b[n:], nil
+    }
+    if prev == nil {
+        return nil, nil, errZstd
+    }
+    return prev, b, nil
+}
+
+// xxh64 computes the 64 bit xxHash with seed zero, of which zstd stores
+// the low 32 bits as the content checksum.
+type xxh64 struct {
+    v     [4]uint64
+    buf   [32]byte
+    n     int
+    total uint64
+}
+
+const (
+    xxhPrime1 uint64 = 11400714785074694791
+    xxhPrime2 uint64 = 14029467366897019727
+    xxhPrime3 uint64 = 1609587929392839161
+    xxhPrime4 uint64 = 9650029242287828579
+    xxhPrime5 uint64 = 2870177450012600261
+)
+
+func newXXH64() *xxh64 {
+    p1 := xxhPrime1
+    return &xxh64{v: [4]uint64{p1 + xxhPrime2, xxhPrime2, 0, -p1}}
+}
+
+func xxhRound(acc, input uint64) uint64 {
+    return bits.RotateLeft64(acc+input*xxhPrime2, 31) * xxhPrime1
+}
+
+func xxhMerge(acc, v uint64) uint64 {
+    return (acc^xxhRound(0, v))*xxhPrime1 + xxhPrime4
+}
+
+func (x *xxh64) write(p []byte) {
+    x.total += uint64(len(p))
+    if x.n > 0 {
+        c := copy(x.buf[x.n:], p)
+        x.n += c
+        p = p[c:]
+        if x.n < 32 {
+            return
+        }
+        x.stripe(x.buf[:])
+        x.n = 0
+    }
+    for len(p) >= 32 {
+        x.stripe(p)
+        p = p[32:]
+    }
+    x.n = copy(x.buf[:], p)
+}
+
+func (x *xxh64) stripe(p []byte) {
+    for i := range x.v {
+        x.v[i] = xxhRound(x.v[i], binary.LittleEndian.Uint64(p[8*i:]))
+    }
+}
+
+func (x *xxh64) sum64() uint64 {
+    var h uint64
+    if x.total >= 32 {
+        v := x.v
+        h = bits.RotateLeft64(v[0], 1) + bits.RotateLeft64(v[1], 7) +
+            bits.RotateLeft64(v[2], 12) + bits.RotateLeft64(v[3], 18)
+        for _, vi := range v {
+            h = xxhMerge(h, vi)
+        }
+    } else {
+        h = xxhPrime5
+    }
+    h += x.total
+    p := x.buf[:x.n]
+    for ; len(p) >= 8; p = p[8:] {
+        h ^= xxhRound(0, binary.LittleEndian.Uint64(p))
+        h = bits.RotateLeft64(h, 27)*xxhPrime1 + xxhPrime4
+    }
+    if len(p) >= 4 {
+        h ^= uint64(binary.LittleEndian.Uint32(p)) * xxhPrime1
+        h = bits.RotateLeft64(h, 23)*xxhPrime2 + xxhPrime3
+        p = p[4:]
+    }
+    for _, c := range p {
+        h ^= uint64(c) * xxhPrime5
+        h = bits.RotateLeft64(h, 11) * xxhPrime1
+    }
+    h ^= h >> 33
+    h *= xxhPrime2
+    h ^= h >> 29
+    h *= xxhPrime3
+    h ^= h >> 32
+    return h
+}
+
+// fseEncoder encodes symbols with an FSE table, in reverse order.
+type fseEncoder struct {
+    log   uint
+    table []uint16
+    tt    []fseSymbolTT
+    state uint32
+}
+
+type fseSymbolTT struct {
+    deltaFindState int32
+    deltaNbBits    uint32
+}
+
+func newFSEEncoder(t *fseTable) *fseEncoder {
+    size := 1 << t.log
+    e := &fseEncoder{log: t.log, table: make([]uint16, size), tt: make([]fseSymbolTT, len(t.norm))}
+    syms := fseSpread(t.norm, t.log)
+    cumul := make([]int, len(t.norm)+1)
+    for s, n := range t.norm {
+        if n == -1 {
+            n = 1
+        }
+        cumul[s+1] = cumul[s] + int(n)
+    }
+    for u, s := range syms {
+        e.table[cumul[s]] = uint16(size + u)
+        cumul[s]++
+    }
+    total := int32(0)
+    for s, n := range t.norm {
+        switch n {
+        case 0:
+            e.tt[s].deltaNbBits = uint32(t.log+1)<<16 - uint32(size)
+        case -1, 1:
+            e.tt[s] = fseSymbolTT{deltaFindState: total - 1, deltaNbBits: uint32(t.log)<<16 - uint32(size)}
+            total++
+        default:
+            maxBitsOut := t.log - uint(bits.Len(uint(n-1))-1)
+            minStatePlus := uint32(n) << maxBitsOut
+            e.tt[s] = fseSymbolTT{deltaFindState: total - int32(n), deltaNbBits: uint32(maxBitsOut)<<16 - minStatePlus}
+            total += int32(n)
+        }
+    }
+    return e
+}
+
+func (e *fseEncoder) init(sym uint8) {
+    tt := e.tt[sym]
+    nb := (tt.deltaNbBits + 1<<15) >> 16
+    v := nb<<16 - tt.deltaNbBits
+    e.state = uint32(e.table[int32(v>>nb)+tt.deltaFindState])
+}
+
+func (e *fseEncoder) encode(bw *lsbWriter, sym uint8) {
+    tt := e.tt[sym]
+    nb := (e.state + tt.deltaNbBits) >> 16
+    bw.writeBits(e.state&(1<<nb-1), uint(nb))
+    e.state = uint32(e.table[int32(e.state>>nb)+tt.deltaFindState])
+}
+
+func (e *fseEncoder) flush(bw *lsbWriter) {
+    bw.writeBits(e.state&(1<<e.log-1), e.log)
+}
+
+var (
+    zstdLLEncoder = newFSEEncoder(zstdLLTable)
+    zstdMLEncoder = newFSEEncoder(zstdMLTable)
+    zstdOFEncoder = newFSEEncoder(zstdOFTable)
+)
+
+// zstdSeq is one sequence: literals to copy, then a match.
+type zstdSeq struct {
+    lit, match, offset uint32
+}
+
+// zstdWriter compresses to a single zstd frame with a content checksum.
+type zstdWriter struct {
+    w      io.Writer
+    m      *matcher
+    pos    int // next position of m.buf to encode
+    xxh    *xxh64
+    out    []byte
+    err    error
+    closed bool
+}
+
+func newZstdWriter(w io.Writer) (io.WriteCloser, error) {
+    z := &zstdWriter{
+        w:   w,
+        m:   newMatcher(1<<zstdWriterWindow, zstdMaxBlock, 32),
+        xxh: newXXH64(),
+    }
+    // Magic, then a header with a content checksum and a window
+    // descriptor.
+    z.out = []byte{0x28, 0xb5, 0x2f, 0xfd, 0x04, (zstdWriterWindow - 10) << 3}
+    return z, nil
+}
+
+func (z *zstdWriter) Write(p []byte) (int, error) {
+    if z.err != nil {
+        return 0, z.err
+    }
+    z.xxh.write(p)
+    z.m.write(p)
+    for len(z.m.buf)-z.pos > zstdMaxBlock {
+        z.block(z.pos+zstdMaxBlock, false)
+    }
+    return len(p), z.err
+}
+
+func (z *zstdWriter) Close() error {
+    if z.closed {
+        return z.err
+    }
+    z.closed = true
+    z.block(len(z.m.buf), true)
+    var sum [4]byte
+    binary.LittleEndian.PutUint32(sum[:], uint32(z.xxh.sum64()))
+    z.out = append(z.out, sum[:]...)
+    z.flush()
+    return z.err
+}
+
+func (z *zstdWriter) flush() {
+    if z.err == nil {
+        _, z.err = z.w.Write(z.out)
+    }
+    z.out = z.out[:0]
+}
+
+// block compresses the buffered data up to end as one block.
+func (z *zstdWriter) block(end int, last bool) {
+    m := z.m
+    data := m.buf[z.pos:end]
+    var seqs []zstdSeq
+    var lits []byte
+    litStart := z.pos
+    for p := z.pos; p < end; {
+        m.insert(p)
+        length, dist := m.find(p, end)
+        if length == 0 || m.deferMatch(p, end, length) {
+            p++
+            continue
+        }
+        lits = append(lits, m.buf[litStart:p]...)
+        seqs = append(seqs, zstdSeq{lit: uint32(p - litStart), match: uint32(length), offset: uint32(dist)})
+        p += length
+        litStart = p
+    }
+    lits = append(lits, m.buf[litStart:end]...)
+
+    body := zstdLiterals(nil, lits)
+    body = zstdSequences(body, seqs)
+    hdr := uint32(boolBit(last))
+    if len(body) < len(data) {
+        hdr |= 2<<1 | uint32(len(body))<<3
+        z.out = append(z.out, byte(hdr), byte(hdr>>8), byte(hdr>>16))
+        z.out = append(z.out, body...)
+    } else {
+        hdr |= uint32(len(data)) << 3
+        z.out = append(z.out, byte(hdr), byte(hdr>>8), byte(hdr>>16))
+        z.out = append(z.out, data...)
+    }
+    z.pos = end
+    m.insert(end)
+    if n := m.slide(z.pos); n > 0 {
+        z.pos -= n
+    }
+    if len(z.out) >= 64<<10 {
+        z.flush()
+    }
+}
+
+// zstdLiterals appends the literals section: Huffman coded when the
+// literals are ASCII and that is smaller, raw otherwise.
+func zstdLiterals(out, lits []byte) []byte {
+    if enc := zstdHuffLiterals(lits); enc != nil && len(enc) < len(lits) {
+        return append(out, enc...)
+    }
+    n := len(lits)
+    switch {
+    case n < 32:
+        out = append(out, byte(n<<3))
+    case n < 4096:
+        out = append(out, byte(1<<2|n<<4), byte(n>>4))
+    default:
+        out = append(out, byte(3<<2|n<<4), byte(n>>4), byte(n>>12))
+    }
+    return append(out, lits...)
+}
+
+// zstdHuffLiterals returns a compressed literals section in four streams,
+// or nil. Only literals below 128 are handled, since the weights are
+// stored directly rather than FSE compressed.
+func zstdHuffLiterals(lits []byte) []byte {
+    if len(lits) < 64 {
+        return nil
+    }
+    freq := make([]int, 128)
+    last := 0
+    for _, c := range lits {
+        if c >= 128 {
+            return nil
+        }
+        freq[c]++
+        if int(c) > last {
+            last = int(c)
+        }
+    }
+    lengths := huffmanLengths(freq[:last+1], 11)
+    maxBits := uint8(0)
+    used := 0
+    for _, l := range lengths {
+        if l > maxBits {
+            maxBits = l
+        }
+        if l > 0 {
+            used++
+        }
+    }
+    if used < 2 {
+        return nil
+    }
+    weights := make([]uint8, len(lengths))
+    for s, l := range lengths {
+        if l > 0 {
+            weights[s] = maxBits + 1 - l
+        }
+    }
+    // Codes follow the decoding table: by weight, then symbol.
+    codes := make([]uint32, len(lengths))
+    pos := 0
+    for w := uint8(1); w <= maxBits; w++ {
+        for s, sw := range weights {
+            if sw == w {
+                codes[s] = uint32(pos >> (w - 1))
+                pos += 1 << (w - 1)
+            }
+        }
+    }
+    var tree []byte
+    n := last // the last weight is implied
+    tree = append(tree, byte(127+n))
+    for i := 0; i < n; i += 2 {
+        b := weights[i] << 4
+        if i+1 < n {
+            b |= weights[i+1]
+        }
+        tree = append(tree, b)
+    }
+    per := (len(lits) + 3) / 4
+    var streams [4][]byte
+    for i := range streams {
+        lo, hi := i*per, (i+1)*per
+        if hi > len(lits) {
+            hi = len(lits)
+        }
+        if lo > hi {
+            lo = hi
+        }
+        seg := lits[lo:hi]
+        var buf bytes.Buffer
+        bw := lsbWriter{w: &buf}
+        for j := len(seg) - 1; j >= 0; j-- {
+            bw.writeBits(codes[seg[j]], uint(lengths[seg[j]]))
+        }
+        bw.writeBits(1, 1)
+        bw.flush()
+        streams[i] = buf.Bytes()
+    }
+    comp := len(tree) + 6
+    for _, s := range streams {
+        if len(s) > 0xffff {
+            return nil
+        }
+        comp += len(s)
+    }
+    regen := len(lits)
+    var hdr []byte
+    switch {
+    case regen < 1<<10 && comp < 1<<10:
+        h := uint32(2 | 1<<2 | regen<<4 | comp<<14)
+        hdr = []byte{byte(h), byte(h >> 8), byte(h >> 16)}
+    case regen < 1<<14 && comp < 1<<14:
+        h := uint32(2 | 2<<2 | regen<<4 | comp<<18)
+        hdr = []byte{byte(h), byte(h >> 8), byte(h >> 16), byte(h >> 24)}
+    case regen < 1<<18 && comp < 1<<18:
+        h := uint64(2 | 3<<2 | regen<<4 | comp<<22)
+        hdr = []byte{byte(h), byte(h >> 8), byte(h >> 16), byte(h >> 24), byte(h >> 32)}
+    default:
+        return nil
+    }
+    out := append(hdr, tree...)
+    for _, s := range streams[:3] {
+        out = append(out, byte(len(s)), byte(len(s)>>8))
+    }
+    for _, s := range streams {
+        out = append(out, s...)
+    }
+    return out
+}
+
+// zstdSequences appends the sequences section, coded with the predefined
+// tables. Offsets are always sent in full rather than as repeat codes.
+func zstdSequences(out []byte, seqs []zstdSeq) []byte {
+    n := len(seqs)
+    switch {
+    case n < 128:
+        out = append(out, byte(n))
+    case n < 0x7f00:
+        out = append(out, byte(n>>8+128), byte(n))
+    default:
+        out = append(out, 255, byte(n-0x7f00), byte((n-0x7f00)>>8))
+    }
+    if n == 0 {
+        return out
+    }
+    out = append(out, 0) // predefined modes
+    llc := make([]uint8, n)
+    mlc := make([]uint8, n)
+    ofc := make([]uint8, n)
+    for i, s := range seqs {
+        llc[i] = zstdLLCode(s.lit)
+        mlc[i] = zstdMLCode(s.match)
+        ofc[i] = uint8(bits.Len32(s.offset+3) - 1)
+    }
+    var buf bytes.Buffer
+    bw := lsbWriter{w: &buf}
+    extra := func(i int) {
+        s := seqs[i]
+        bw.writeBits(s.lit-zstdLLBase[llc[i]], uint(zstdLLBits[llc[i]]))
+        bw.writeBits(s.match-zstdMLBase[mlc[i]], uint(zstdMLBits[mlc[i]]))
+        bw.writeBits(s.offset+3-1<<ofc[i], uint(ofc[i]))
+    }
+    ll, ml, of := *zstdLLEncoder, *zstdMLEncoder, *zstdOFEncoder
+    ml.init(mlc[n-1])
+    of.init(ofc[n-1])
+    ll.init(llc[n-1])
+    extra(n - 1)
+    for i := n - 2; i >= 0; i-- {
+        of.encode(&bw, ofc[i])
+        ml.encode(&bw, mlc[i])
+        ll.encode(&bw, llc[i])
+        extra(i)
+    }
+    ml.flush(&bw)
+    of.flush(&bw)
+    ll.flush(&bw)
+    bw.writeBits(1, 1)
+    bw.flush()
+    return append(out, buf.Bytes()...)
+}
+
+func zstdLLCode(ll uint32) uint8 {
+    if ll < 16 {
+        return uint8(ll)
+    }
+    c := 16
+    for c < 35 && zstdLLBase[c+1] <= ll {
+        c++
+    }
+    return uint8(c)
+}
+
+func zstdMLCode(ml uint32) uint8 {
+    if ml < 35 {
+        return uint8(ml - 3)
+    }
+    c := 32
+    for c < 52 && zstdMLBase[c+1] <= ml {
+        c++
+    }
+    return uint8(c)
+}
@@ -0,0 +1,60 @@
+package archive
+
+import (
+    "bytes"
+    "io/ioutil"
+    "testing"
+)
+
+func TestZstdRoundTrip(t *testing.T) {
+    roundTrip(t, newZstdWriter, newZstdReader)
+}
+
+func TestZstdStandard(t *testing.T) {
+    // gopher.zst was written by the reference zstd tool.
+    want, err := ioutil.ReadFile("testdata/gopher.txt")
+    if err != nil {
+        t.Fatal(err)
+    }
+    data, err := ioutil.ReadFile("testdata/gopher.zst")
+    if err != nil {
+        t.Fatal(err)
+    }
+    got, err := ioutil.ReadAll(newZstdReader(bytes.NewReader(data)))
+    if err != nil {
+        t.Fatal(err)
+    }
+    if !bytes.Equal(got, want) {
+        t.Errorf("got %q, want %q", got, want)
+    }
+}
+
+func TestZstdCorrupt(t *testing.T) {
+    var buf bytes.Buffer
+    w, _ := newZstdWriter(&buf)
+    w.Write(bytes.Repeat([]byte("gopher "), 1000))
+    w.Close()
+    data := buf.Bytes()
+    data[len(data)-1] ^= 1 // content checksum
+    if _, err := ioutil.ReadAll(newZstdReader(bytes.NewReader(data))); err != errZstd {
+        t.Errorf("got %v, want %v", err, errZstd)
+    }
+}
+
+func TestXXH64(t *testing.T) {
+    for _, tc := range []struct {
+        in   string
+        want uint64
+    }{
+        {"", 0xef46db3751d8e999},
+        {"a", 0xd24ec4f1a98c6e5b},
+        {"abc", 0x44bc2cf5ad770999},
+        {"Nobody inspects the spammish repetition", 0xfbcea83c8a378bf1},
+    } {
+        x := newXXH64()
+        x.write([]byte(tc.in))
+        if got := x.sum64(); got != tc.want {
+            t.Errorf("xxh64(%q) = %#x, want %#x", tc.in, got, tc.want)
+        }
+    }
+}
@@ -0,0 +1,330 @@
+// Command archive works with tar, zip and the other archive formats of
+// package archive.
+//
+// Usage:
+//
+//	archive <command> [flags] [arguments]
+//
+// The commands are:
+//
+//	search   print lines matching a pattern, inside nested archives too
+//	manifest print the JSON manifest of an archive
+//	build    create an archive from a JSON manifest
+//	store    create, list, restore and prune deduplicated snapshots
+package main
+
+import (
+    "context"
+    "errors"
+    "flag"
+    "fmt"
+    "io"
+    "os"
+    "os/signal"
+    "path/filepath"
+    "regexp"
+    "strings"
+    "time"
+
+    "github/MarkRepo/GoSTL/archive"
+)
+
+type command struct {
+    name    string
+    summary string
+    run     func(ctx context.Context, args []string, stdout, stderr io.Writer) int
+}
+
+var commands = []command{
+    {"search", "print lines matching a pattern, inside nested archives too", runSearch},
+    {"manifest", "print the JSON manifest of an archive", runManifest},
+    {"build", "create an archive from a JSON manifest", runBuild},
+    {"store", "create, list, restore and prune deduplicated snapshots", runStore},
+}
+
+func main() {
+    ctx, cancel := context.WithCancel(context.Background())
+    sig := make(chan os.Signal, 1)
+    signal.Notify(sig, os.Interrupt)
+    go func() {
+        <-sig
+        cancel()
+    }()
+    os.Exit(run(ctx, os.Args[1:], os.Stdout, os.Stderr))
+}
+
+// run executes the command line args and returns the exit status.
+func run(ctx context.Context, args []string, stdout, stderr io.Writer) int {
+    if len(args) == 0 {
+        usage(stderr)
+        return 2
+    }
+    for _, c := range commands {
+        if c.name == args[0] {
+            return c.run(ctx, args[1:], stdout, stderr)
+        }
+    }
+    fmt.Fprintf(stderr, "archive: unknown command %q\n", args[0])
+    usage(stderr)
+    return 2
+}
+
+func usage(w io.Writer) {
+    fmt.Fprintln(w, "usage: archive <command> [flags] [arguments]")
+    fmt.Fprintln(w, "\ncommands:")
+    for _, c := range commands {
+        fmt.Fprintf(w, "  %-8s %s\n", c.name, c.summary)
+    }
+}
+
+// runSearch prints matching lines like grep: the exit status is 0 if a
+// line matched, 1 if none did and 2 on errors.
+func runSearch(ctx context.Context, args []string, stdout, stderr io.Writer) int {
+    fs := flag.NewFlagSet("search", flag.ContinueOnError)
+    fs.SetOutput(stderr)
+    depth := fs.Int("depth", archive.DefaultSearchDepth, "levels of nested archives to enter")
+    ignoreCase := fs.Bool("i", false, "ignore case")
+    keepGoing := fs.Bool("k", false, "report unreadable members and keep going")
+    fs.Usage = func() {
+        fmt.Fprintln(stderr, "usage: archive search [flags] pattern file...")
+        fs.PrintDefaults()
+    }
+    if err := fs.Parse(args); err != nil {
+        return 2
+    }
+    if fs.NArg() < 2 {
+        fs.Usage()
+        return 2
+    }
+    pattern := fs.Arg(0)
+    if *ignoreCase {
+        pattern = "(?i)" + pattern
+    }
+    re, err := regexp.Compile(pattern)
+    if err != nil {
+        fmt.Fprintf(stderr, "archive: %v\n", err)
+        return 2
+    }
+    opts := &archive.SearchOptions{MaxDepth: *depth}
+    if *depth == 0 {
+        opts.MaxDepth = -1
+    }
+    failed := false
+    if *keepGoing {
+        opts.OnError = func(path []string, err error) {
+            fmt.Fprintf(stderr, "archive: %s: %v\n", strings.Join(path, "!/"), err)
+            failed = true
+        }
+    }
+    matched := false
+    for _, name := range fs.Args()[1:] {
+        err := archive.SearchFile(ctx, name, re, opts, func(m archive.SearchMatch) error {
+            matched = true
+            _, err := fmt.Fprintln(stdout, m)
+            return err
+        })
+        if err != nil {
+            fmt.Fprintf(stderr, "archive: %v\n", err)
+            if errors.Is(err, context.Canceled) {
+                return 2
+            }
+            failed = true
+        }
+    }
+    switch {
+    case failed:
+        return 2
+    case matched:
+        return 0
+    }
+    return 1
+}
+
+// runManifest prints the manifest of an archive file.
+func runManifest(ctx context.Context, args []string, stdout, stderr io.Writer) int {
+    fs := flag.NewFlagSet("manifest", flag.ContinueOnError)
+    fs.SetOutput(stderr)
+    fs.Usage = func() {
+        fmt.Fprintln(stderr, "usage: archive manifest file")
+        fs.PrintDefaults()
+    }
+    if err := fs.Parse(args); err != nil {
+        return 2
+    }
+    if fs.NArg() != 1 {
+        fs.Usage()
+        return 2
+    }
+    m, err := archive.ExportManifestFile(fs.Arg(0))
+    if err == nil {
+        err = archive.WriteManifest(stdout, m)
+    }
+    if err != nil {
+        fmt.Fprintf(stderr, "archive: %v\n", err)
+        return 1
+    }
+    return 0
+}
+
+// runBuild creates an archive from a manifest. Sources are found relative
+// to the manifest file.
+func runBuild(ctx context.Context, args []string, stdout, stderr io.Writer) int {
+    fs := flag.NewFlagSet("build", flag.ContinueOnError)
+    fs.SetOutput(stderr)
+    formatName := fs.String("format", "", "archive format, tar or zip; by default chosen from the output name")
+    fs.Usage = func() {
+        fmt.Fprintln(stderr, "usage: archive build [flags] manifest.json output")
+        fs.PrintDefaults()
+    }
+    if err := fs.Parse(args); err != nil {
+        return 2
+    }
+    if fs.NArg() != 2 {
+        fs.Usage()
+        return 2
+    }
+    manifestName, out := fs.Arg(0), fs.Arg(1)
+    format := archive.FormatFromName(out)
+    switch *formatName {
+    case "":
+    case "tar":
+        format = archive.FormatTar
+    case "zip":
+        format = archive.FormatZip
+    default:
+        format = archive.FormatUnknown
+    }
+    if format != archive.FormatTar && format != archive.FormatZip {
+        fmt.Fprintf(stderr, "archive: cannot build %s: choose -format tar or zip\n", out)
+        return 2
+    }
+    if err := build(manifestName, out, format); err != nil {
+        fmt.Fprintf(stderr, "archive: %v\n", err)
+        return 1
+    }
+    return 0
+}
+
+func build(manifestName, out string, format archive.Format) (err error) {
+    mf, err := os.Open(manifestName)
+    if err != nil {
+        return err
+    }
+    m, err := archive.ReadManifest(mf)
+    mf.Close()
+    if err != nil {
+        return err
+    }
+    f, err := os.Create(out)
+    if err != nil {
+        return err
+    }
+    defer func() {
+        if cerr := f.Close(); err == nil {
+            err = cerr
+        }
+        if err != nil {
+            os.Remove(out)
+        }
+    }()
+    return archive.BuildManifest(f, m, filepath.Dir(manifestName), format)
+}
+
+const storeUsage = `usage: archive store create store name source
+       archive store list store
+       archive store restore store name dir
+       archive store prune [-keep n] store [name...]
+
+The source of a snapshot is a directory or an archive file. Prune removes
+the named snapshots, then all but the newest n if -keep is set, and deletes
+the chunks no snapshot refers to any more.`
+
+// runStore manages a deduplicating snapshot store in a local directory.
+func runStore(ctx context.Context, args []string, stdout, stderr io.Writer) int {
+    fs := flag.NewFlagSet("store", flag.ContinueOnError)
+    fs.SetOutput(stderr)
+    keep := fs.Int("keep", -1, "prune: keep only the newest `n` snapshots")
+    fs.Usage = func() {
+        fmt.Fprintln(stderr, storeUsage)
+        fs.PrintDefaults()
+    }
+    if len(args) == 0 {
+        fs.Usage()
+        return 2
+    }
+    sub := args[0]
+    if err := fs.Parse(args[1:]); err != nil {
+        return 2
+    }
+    nargs := map[string]int{"create": 3, "list": 1, "restore": 3, "prune": -1}[sub]
+    if nargs == 0 || fs.NArg() < 1 || nargs > 0 && fs.NArg() != nargs || *keep >= 0 && sub != "prune" {
+        fs.Usage()
+        return 2
+    }
+    if err := store(ctx, sub, fs.Args(), *keep, stdout); err != nil {
+        fmt.Fprintf(stderr, "archive: %v\n", err)
+        return 1
+    }
+    return 0
+}
+
+func store(ctx context.Context, sub string, args []string, keep int, stdout io.Writer) error {
+    // Only create makes a new store; OpenStore would make one anywhere.
+    if sub != "create" {
+        if _, err := os.Stat(filepath.Join(args[0], "config.json")); os.IsNotExist(err) {
+            return fmt.Errorf("%s is not a store", args[0])
+        } else if err != nil {
+            return err
+        }
+    }
+    s, err := archive.OpenStore(args[0], nil)
+    if err != nil {
+        return err
+    }
+    switch sub {
+    case "create":
+        fi, err := os.Stat(args[2])
+        if err != nil {
+            return err
+        }
+        if fi.IsDir() {
+            _, err = s.CreateFromDir(ctx, args[1], args[2])
+        } else {
+            _, err = s.CreateFromFile(ctx, args[1], args[2])
+        }
+        return err
+    case "list":
+        snaps, err := s.Snapshots()
+        if err != nil {
+            return err
+        }
+        for _, snap := range snaps {
+            fmt.Fprintf(stdout, "%s\t%s\t%d entries\t%d bytes\n", snap.Name, snap.Created.Format(time.RFC3339), len(snap.Entries), snap.Size())
+        }
+        return nil
+    case "restore":
+        return s.Restore(ctx, args[1], args[2], nil)
+    }
+    for _, name := range args[1:] {
+        if err := s.Remove(name); err != nil {
+            return err
+        }
+    }
+    if keep >= 0 {
+        snaps, err := s.Snapshots()
+        if err != nil {
+            return err
+        }
+        for i := 0; i < len(snaps)-keep; i++ {
+            if err := s.Remove(snaps[i].Name); err != nil {
+                return err
+            }
+        }
+    }
+    stats, err := s.Prune()
+    if err != nil {
+        return err
+    }
+    fmt.Fprintf(stdout, "deleted %d chunks, %d bytes\n", stats.Chunks, stats.Bytes)
+    return nil
+}
@@ -0,0 +1,125 @@
+package main
+
+import (
+    "archive/zip"
+    "bytes"
+    "context"
+    "io/ioutil"
+    "os"
+    "path/filepath"
+    "strings"
+    "testing"
+)
+
+func TestSearch(t *testing.T) {
+    dir, err := ioutil.TempDir("", "archive-cmd")
+    if err != nil {
+        t.Fatal(err)
+    }
+    defer os.RemoveAll(dir)
+    name := filepath.Join(dir, "bundle.zip")
+    var buf bytes.Buffer
+    zw := zip.NewWriter(&buf)
+    fw, _ := zw.Create("app.log")
+    fw.Write([]byte("starting\nERROR disk full\nstopping\n"))
+    zw.Close()
+    if err := ioutil.WriteFile(name, buf.Bytes(), 0644); err != nil {
+        t.Fatal(err)
+    }
+
+    var stdout, stderr bytes.Buffer
+    if code := run(context.Background(), []string{"search", "-i", "error", name}, &stdout, &stderr); code != 0 {
+        t.Fatalf("exit status %d: %s", code, stderr.String())
+    }
+    if want := name + "!/app.log:2: ERROR disk full\n"; stdout.String() != want {
+        t.Errorf("got %q, want %q", stdout.String(), want)
+    }
+    stdout.Reset()
+    if code := run(context.Background(), []string{"search", "warning", name}, &stdout, &stderr); code != 1 || stdout.Len() != 0 {
+        t.Errorf("no match: exit status %d, output %q", code, stdout.String())
+    }
+    if code := run(context.Background(), []string{"frobnicate"}, &stdout, &stderr); code != 2 || !strings.Contains(stderr.String(), "unknown command") {
+        t.Errorf("unknown command: exit status %d", code)
+    }
+}
+
+func TestManifestBuild(t *testing.T) {
+    dir, err := ioutil.TempDir("", "archive-cmd")
+    if err != nil {
+        t.Fatal(err)
+    }
+    defer os.RemoveAll(dir)
+    ioutil.WriteFile(filepath.Join(dir, "hello.txt"), []byte("hello\n"), 0644)
+    manifest := `{"entries": [
+        {"name": "greeting.txt", "source": "hello.txt", "mode": "0600"},
+        {"name": "inline.txt", "content": "inline\n"}
+    ]}`
+    ioutil.WriteFile(filepath.Join(dir, "m.json"), []byte(manifest), 0644)
+
+    out := filepath.Join(dir, "out.zip")
+    var stdout, stderr bytes.Buffer
+    if code := run(context.Background(), []string{"build", filepath.Join(dir, "m.json"), out}, &stdout, &stderr); code != 0 {
+        t.Fatalf("build: exit status %d: %s", code, stderr.String())
+    }
+    if code := run(context.Background(), []string{"manifest", out}, &stdout, &stderr); code != 0 {
+        t.Fatalf("manifest: exit status %d: %s", code, stderr.String())
+    }
+    for _, want := range []string{`"name": "greeting.txt"`, `"mode": "0600"`, `"name": "inline.txt"`, `"size": 7`} {
+        if !strings.Contains(stdout.String(), want) {
+            t.Errorf("manifest lacks %s:\n%s", want, stdout.String())
+        }
+    }
+    if code := run(context.Background(), []string{"build", filepath.Join(dir, "m.json"), filepath.Join(dir, "out.rar")}, &stdout, &stderr); code != 2 {
+        t.Errorf("unknown output format: exit status %d", code)
+    }
+}
+
+func TestStore(t *testing.T) {
+    dir, err := ioutil.TempDir("", "archive-cmd")
+    if err != nil {
+        t.Fatal(err)
+    }
+    defer os.RemoveAll(dir)
+    src, store := filepath.Join(dir, "src"), filepath.Join(dir, "store")
+    os.Mkdir(src, 0755)
+    ioutil.WriteFile(filepath.Join(src, "a.txt"), []byte("first\n"), 0644)
+    ioutil.WriteFile(filepath.Join(src, "b.txt"), []byte("second\n"), 0644)
+
+    var stdout, stderr bytes.Buffer
+    storeRun := func(args ...string) {
+        t.Helper()
+        stdout.Reset()
+        if code := run(context.Background(), append([]string{"store"}, args...), &stdout, &stderr); code != 0 {
+            t.Fatalf("store %s: exit status %d: %s", args[0], code, stderr.String())
+        }
+    }
+    storeRun("create", store, "monday", src)
+    ioutil.WriteFile(filepath.Join(src, "b.txt"), []byte("changed\n"), 0644)
+    storeRun("create", store, "tuesday", src)
+    storeRun("list", store)
+    if lines := strings.Split(strings.TrimSpace(stdout.String()), "\n"); len(lines) != 2 || !strings.HasPrefix(lines[0], "monday\t") || !strings.HasSuffix(lines[1], "\t2 entries\t14 bytes") {
+        t.Errorf("list:\n%s", stdout.String())
+    }
+    storeRun("prune", "-keep", "1", store)
+    if want := "deleted 1 chunks, 7 bytes\n"; stdout.String() != want {
+        t.Errorf("prune: %q, want %q", stdout.String(), want)
+    }
+    dst := filepath.Join(dir, "dst")
+    storeRun("restore", store, "tuesday", dst)
+    if b, err := ioutil.ReadFile(filepath.Join(dst, "b.txt")); err != nil || string(b) != "changed\n" {
+        t.Errorf("restored b.txt = %q, %v", b, err)
+    }
+    if code := run(context.Background(), []string{"store", "restore", store, "monday", dst}, &stdout, &stderr); code != 1 {
+        t.Errorf("restoring a pruned snapshot: exit status %d", code)
+    }
+
+    // Other commands do not turn a directory into a store.
+    for _, sub := range []string{"list", "prune"} {
+        if code := run(context.Background(), []string{"store", sub, src}, &stdout, &stderr); code != 1 {
+            t.Errorf("%s of a plain directory: exit status %d", sub, code)
+        }
+    }
+    if _, err := os.Stat(filepath.Join(src, "config.json")); !os.IsNotExist(err) {
+        t.Errorf("config.json written to a plain directory: %v", err)
+    }
+}
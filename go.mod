@@ -1,3 +1,3 @@
 module github/MarkRepo/GoSTL
 
-go 1.14
+go 1.17